@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	czm_model "github.com/gre-ory/games-go/internal/game/czm/model"
+	czm_service "github.com/gre-ory/games-go/internal/game/czm/service"
+	czm_store "github.com/gre-ory/games-go/internal/game/czm/store"
+	skj_model "github.com/gre-ory/games-go/internal/game/skj/model"
+	skj_service "github.com/gre-ory/games-go/internal/game/skj/service"
+	skj_store "github.com/gre-ory/games-go/internal/game/skj/store"
+	ttt_model "github.com/gre-ory/games-go/internal/game/ttt/model"
+	ttt_service "github.com/gre-ory/games-go/internal/game/ttt/service"
+	ttt_store "github.com/gre-ory/games-go/internal/game/ttt/store"
+)
+
+// //////////////////////////////////////////////////
+// main
+
+// simulate plays games between bots in-process and reports broken invariants, panics, game length & win rates.
+//
+//	go run ./cmd/simulate -game skj -games 5000 -players 3
+func main() {
+
+	//
+	// flags
+	//
+
+	game := flag.String("game", "ttt", "game to simulate: ttt, czm or skj")
+	nbGame := flag.Int("games", simulation.DefaultNbGame, "number of games to simulate")
+	nbPlayer := flag.Int("players", 2, "number of players per game")
+	nbWorker := flag.Int("workers", 0, "number of games simulated at the same time ( 0 means one per cpu )")
+	maxNbAction := flag.Int("max-actions", simulation.DefaultMaxNbAction, "number of actions after which a game is reported as endless")
+	nbFailure := flag.Int("failures", 10, "number of failures to detail")
+	verbose := flag.Bool("verbose", false, "log the actions of the games")
+	var tttSettings ttt_model.Settings
+	var czmSettings czm_model.Settings
+	var skjSettings skj_model.Settings
+	flag.IntVar(&skjSettings.ScoreThreshold, "score-threshold", 0, "score ending the game ( skj )")
+	flag.StringVar(&czmSettings.Difficulty, "difficulty", "", "difficulty ( czm )")
+	flag.IntVar(&tttSettings.NbRow, "rows", 0, "number of rows ( ttt )")
+	flag.IntVar(&tttSettings.NbColumn, "columns", 0, "number of columns ( ttt )")
+	flag.IntVar(&tttSettings.NbInARow, "in-a-row", 0, "number of aligned symbols to win ( ttt )")
+	flag.Parse()
+
+	logger := zap.NewNop()
+	if *verbose {
+		logger = zap.Must(zap.NewDevelopment())
+	}
+
+	//
+	// simulate
+	//
+
+	start := time.Now()
+	var report simulation.Report
+	switch *game {
+	case "ttt":
+		config := ttt_service.NewSimulationConfig(*nbPlayer)
+		config.NbGame, config.NbWorker, config.MaxNbAction, config.Settings = *nbGame, *nbWorker, *maxNbAction, tttSettings
+		report = simulation.Run(ttt_service.NewGameService(logger, ttt_store.NewGameStore()), config)
+	case "czm":
+		config := czm_service.NewSimulationConfig(*nbPlayer)
+		config.NbGame, config.NbWorker, config.MaxNbAction, config.Settings = *nbGame, *nbWorker, *maxNbAction, czmSettings
+		report = simulation.Run(czm_service.NewGameService(logger, czm_store.NewGameStore()), config)
+	case "skj":
+		config := skj_service.NewSimulationConfig(*nbPlayer)
+		config.NbGame, config.NbWorker, config.MaxNbAction, config.Settings = *nbGame, *nbWorker, *maxNbAction, skjSettings
+		report = simulation.Run(skj_service.NewGameService(logger, skj_store.NewGameStore()), config)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown game %q\n", *game)
+		os.Exit(2)
+	}
+
+	//
+	// report
+	//
+
+	fmt.Printf("%s in %s\n\n", *game, time.Since(start).Round(time.Millisecond))
+	fmt.Print(report)
+	for index, failure := range report.Failures {
+		if index >= *nbFailure {
+			fmt.Printf("\n... %d more failures\n", len(report.Failures)-index)
+			break
+		}
+		fmt.Printf("\n%s\n", failure.Error())
+		if failure.Panic {
+			fmt.Println(failure.Stack)
+		}
+	}
+	if report.HasFailures() {
+		os.Exit(1)
+	}
+}
@@ -59,6 +59,8 @@ type gameServer struct {
 func (s *gameServer) RegisterRoutes(router *httprouter.Router) {
 	s.logger.Info(fmt.Sprintf(" (+) GET %s", model.App.HomeRoute()))
 	router.HandlerFunc(http.MethodGet, model.App.HomeRoute(), s.page_home())
+	s.logger.Info(fmt.Sprintf(" (+) GET %s", model.App.JoinRoute()))
+	router.HandlerFunc(http.MethodGet, model.App.JoinRoute(), s.page_join())
 	s.HubServer.RegisterAppRoutes(router, model.App)
 }
 
@@ -66,6 +68,10 @@ func (s *gameServer) page_home() func(http.ResponseWriter, *http.Request) {
 	return share_api.PageHome(s.logger, model.App, s, s)
 }
 
+func (s *gameServer) page_join() func(http.ResponseWriter, *http.Request) {
+	return share_api.PageJoin(s.logger, model.App, s, s)
+}
+
 // //////////////////////////////////////////////////
 // wrap data
 
@@ -95,5 +101,5 @@ func (s *gameServer) WrapPlayerData(data share_model.Data, player *model.Player)
 // cookie
 
 func (s *gameServer) newUserFromCookie(cookie *share_model.Cookie) share_websocket.User {
-	return share_websocket.NewUser(s.logger, cookie, s.onMessage, s.OnUserUpdate, nil)
+	return share_websocket.NewUser(s.logger, cookie, s.onMessage, s.OnUserUpdate, s.OnUserClose)
 }
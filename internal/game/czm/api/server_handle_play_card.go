@@ -6,14 +6,14 @@ import (
 	"github.com/gre-ory/games-go/internal/game/czm/model"
 )
 
-func (s *gameServer) HandlePlayCard(player *model.Player, discardNumber int) error {
+func (s *gameServer) HandlePlayCard(player *model.Player, version, discardNumber int) error {
 	s.logger.Info("[ws] play_card", zap.Int("discardNumber", discardNumber))
 
 	if discardNumber == 0 {
 		return model.ErrInvalidDiscardNumber
 	}
 
-	game, err := s.service.PlayCard(player, discardNumber)
+	game, err := s.service.PlayCard(player, version, discardNumber)
 	if err != nil {
 		return err
 	}
@@ -6,14 +6,14 @@ import (
 	"github.com/gre-ory/games-go/internal/game/czm/model"
 )
 
-func (s *gameServer) HandleSelectCard(player *model.Player, cardNumber int) error {
+func (s *gameServer) HandleSelectCard(player *model.Player, version, cardNumber int) error {
 	s.logger.Info("[ws] select_card", zap.Int("card", cardNumber))
 
 	if cardNumber == 0 {
 		return model.ErrInvalidCardNumber
 	}
 
-	game, err := s.service.SelectCard(player, cardNumber)
+	game, err := s.service.SelectCard(player, version, cardNumber)
 	if err != nil {
 		return err
 	}
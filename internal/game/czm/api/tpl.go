@@ -22,6 +22,7 @@ var (
 			New("").
 			Funcs(template.FuncMap{
 				"dict": util.TplDict,
+				"inc":  util.TplInc,
 			}).
 			ParseFS(tplFS, "tpl/*.tpl"),
 	)
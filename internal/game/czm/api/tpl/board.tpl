@@ -1,28 +1,70 @@
 {{- define "board" }}
 {{- $lang := .Lang }}
 {{- if .Game.WasStarted }}
-{{- $playing := .Player.IsPlaying }}
-<div id="board" class="{{ .Player.Labels }}" hx-swap-oob="outerHTML">
+{{- $playing := and (not .Spectator) .Player.IsPlaying }}
+<div id="board" class="{{ if .Spectator }}spectator{{ else }}{{ .Player.Labels }}{{ end }}" data-version="{{ .Game.Version }}" hx-swap-oob="outerHTML">
     <div class="board cols-5">
-    {{ template "deck" dict "Deck" .Game.DrawDeck }}
-    {{- range $y, $deck := .Game.DiscardDecks }}
-    {{ template "card" dict "Card" $deck.TopCard }}
+    {{ template "deck" dict "Deck" .Game.DrawCardDeck }}
+    {{- range $y, $deck := .Game.DiscardCardDecks }}
+    {{- if $playing }}
+    <div class="discard selectable" ws-send data-action="play-card" data-discard="{{ inc $y }}">
+        {{ template "card" dict "Card" $deck.GetTopCard }}
+    </div>
+    {{- else }}
+    {{ template "card" dict "Card" $deck.GetTopCard }}
     {{- end }}
+    {{- end }}
+    </div>
+    <div class="rules center">
+        {{ $lang.Loc .Game.Difficulty.LocKey }} - {{ $lang.Loc .Game.Difficulty.HintsLocKey }}
     </div>
+    <div class="missions center">
+        {{ $lang.Loc "CompletedMissions" .Game.DiscardMissionDeck.Size }}
+        {{- if .Game.IsStopped }}
+        <div class="medal {{ .Game.Medal }}">{{ $lang.Loc .Game.Medal.LocKey }}</div>
+        {{- end }}
+    </div>
+    <div id="countdown" class="countdown center"></div>
     <div class="center">
-        {{- if .Game.Stopped }}
-            <button ws-send data-action="create-game">{{ $lang.Loc "NewGameAction" }}</button>
+        {{- if and .Game.IsStopped (not .Spectator) }}
+            <button ws-send data-action="rematch">{{ $lang.Loc "RematchAction" }}</button>
+            <button ws-send data-action="create-game" data-difficulty="{{ .Game.Difficulty }}">{{ $lang.Loc "NewGameAction" }}</button>
         {{- end }}
         <button ws-send data-action="leave-game">{{ $lang.Loc "LeaveAction" }}</button>
     </div>
 </div>
 {{- else }}
 <div id="board" class="center" hx-swap-oob="outerHTML">
-    {{- if .Game.CanStart }}
+    {{- if .Game.IsPrivate }}
+    <div class="invite center">
+        {{ $lang.Loc "InviteLink" }} <a href="/czm/join/{{ .Game.InviteCode }}">/czm/join/{{ .Game.InviteCode }}</a>
+    </div>
+    {{- end }}
+    <div class="rules center">
+        {{ $lang.Loc .Game.Difficulty.LocKey }} - {{ $lang.Loc .Game.Difficulty.HintsLocKey }}
+    </div>
+    {{- $host := .Game.IsHost .Player.Id }}
+    {{- if .Player.IsReady }}
+    <button ws-send data-action="set-ready" data-ready="false">{{ $lang.Loc "NotReadyAction" }}</button>
+    {{- else }}
+    <button ws-send data-action="set-ready" data-ready="true">{{ $lang.Loc "ReadyAction" }}</button>
+    {{- end }}
+    {{- if and $host .Game.IsReadyToStart }}
     <button ws-send data-action="start-game">{{ $lang.Loc "StartAction" }}</button>
     {{- else }}
     <button class="off">{{ $lang.Loc "StartAction" }}</button>
     {{- end }}
+    {{- if $host }}
+    <button ws-send data-action="update-settings" data-turn-timeout="{{ .Game.TurnTimeout.Seconds }}" data-private="{{ not .Game.IsPrivate }}" data-ready-check="{{ .Game.HasReadyCheck }}">
+        {{- if .Game.IsPrivate }}{{ $lang.Loc "MakePublicAction" }}{{ else }}{{ $lang.Loc "MakePrivateAction" }}{{ end -}}
+    </button>
+    <button ws-send data-action="update-settings" data-turn-timeout="{{ .Game.TurnTimeout.Seconds }}" data-private="{{ .Game.IsPrivate }}" data-ready-check="{{ not .Game.HasReadyCheck }}">
+        {{- if .Game.HasReadyCheck }}{{ $lang.Loc "NoReadyCheckAction" }}{{ else }}{{ $lang.Loc "ReadyCheckAction" }}{{ end -}}
+    </button>
+    {{- end }}
+    {{- if and $host .Game.CanJoin }}
+    <button ws-send data-action="add-bot">{{ $lang.Loc "AddBotAction" }}</button>
+    {{- end }}
     <button ws-send data-action="leave-game">{{ $lang.Loc "LeaveAction" }}</button>
 </div>
 {{- end }}
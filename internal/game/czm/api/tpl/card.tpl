@@ -1,6 +1,6 @@
 {{- define "card" }}
 {{- if .Selectable }}
-<div class="{{ .Card.Labels }} selectable" ws-send data-action="select-card" data-card="{{ .CardIndex }}">
+<div class="{{ .Card.Labels }} selectable" ws-send data-action="select-card" data-card="{{ .CardNumber }}">
 {{- else if .Selected }}
 <div class="{{ .Card.Labels }} selected">
 {{- else }}
@@ -6,9 +6,11 @@
         <div id="board">
             {{ .Share.LoadingDot }}
         </div>
+        {{- if not .Spectator }}
         <div id="board-player">
             {{ .Share.LoadingDot }}
         </div>
+        {{- end }}
     </div>
 {{- end }}
 
@@ -1,13 +1,14 @@
 {{- define "player-board" }}
 {{- $lang := .Lang }}
-<div id="player-board" hx-swap-oob="outerHTML">
+<div id="player-board" data-version="{{ .Game.Version }}" hx-swap-oob="outerHTML">
     <div class="cols-4">
     {{- $game := .Game }}
     {{- $player := .Player }}
     {{- range $y, $card := $player.Cards }}
-    {{- $selectable := $game.IsCardSelectable $player.Id $y }}
-    {{- $selected := $game.IsCardSelected $player.Id $y }}
-    {{ template "card" dict "Card" $card "Selectable" $selectable "Selected" $selected "CardIndex" $y }}
+    {{- $number := inc $y }}
+    {{- $selectable := $game.IsCardSelectable $player.Id $number }}
+    {{- $selected := $game.IsCardSelected $player.Id $number }}
+    {{ template "card" dict "Card" $card "Selectable" $selectable "Selected" $selected "CardNumber" $number }}
     {{- end }}
     </div>
 </div>
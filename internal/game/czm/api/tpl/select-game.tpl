@@ -21,6 +21,7 @@
             <div class="cols-1">
                 <div class="join-game col-1 item {{ .Labels }}"> 
                     <div class="title center">{{ $lang.Loc "GameTitle" .Id }}</div>
+                    <div class="difficulty center {{ .Difficulty }}">{{ $lang.Loc .Difficulty.LocKey }}</div>
                     <div class="content">
                         <div class="left">
                         {{- range .Players }}
@@ -46,6 +47,26 @@
                     <button ws-send data-action="create-game">
                         {{ $lang.Loc "CreateAction" }}
                     </button>
+                    <button ws-send data-action="create-game" data-turn-timeout="30">
+                        {{ $lang.Loc "CreateTimedAction" 30 }}
+                    </button>
+                    <button ws-send data-action="create-game" data-private="true">
+                        {{ $lang.Loc "CreatePrivateAction" }}
+                    </button>
+                    <button ws-send data-action="create-game" data-ready-check="true">
+                        {{ $lang.Loc "CreateReadyCheckAction" }}
+                    </button>
+                </div>
+                <div class="content right">
+                    <button ws-send data-action="create-game" data-difficulty="easy">
+                        {{ $lang.Loc "CreateDifficultyAction" ($lang.Loc "DifficultyEasy") }}
+                    </button>
+                    <button ws-send data-action="create-game" data-difficulty="hard">
+                        {{ $lang.Loc "CreateDifficultyAction" ($lang.Loc "DifficultyHard") }}
+                    </button>
+                    <button ws-send data-action="create-game" data-difficulty="expert">
+                        {{ $lang.Loc "CreateDifficultyAction" ($lang.Loc "DifficultyExpert") }}
+                    </button>
                 </div>
             </div>
         </div>
@@ -54,6 +75,7 @@
             <div class="cols-1">
                 <div class="join-game col-1 item {{ .Labels }}"> 
                     <div class="title center">{{ $lang.Loc "GameTitle" .Id }}</div>
+                    <div class="difficulty center {{ .Difficulty }}">{{ $lang.Loc .Difficulty.LocKey }}</div>
                     <div class="content">
                         <div class="left">
                         {{- range .Players }}
@@ -67,5 +89,29 @@
                 </div>
             </div>
         {{- end }}
+        {{- range .RunningGames }}
+        {{- $game := . }}
+            <div class="cols-1">
+                <div class="spectate-game col-1 item {{ .Labels }}">
+                    <div class="title center">{{ $lang.Loc "GameTitle" .Id }}</div>
+                    <div class="difficulty center {{ .Difficulty }}">{{ $lang.Loc .Difficulty.LocKey }}</div>
+                    <div class="content">
+                        <div class="left">
+                        {{- range .Players }}
+                            <div class="badge user {{ $game.PlayerLabels .Id }}">
+                                {{ .User.Avatar.XS }}
+                                <div class="name truncate">{{ .User.Name }}</div>
+                            </div>
+                        {{- end }}
+                        </div>
+                        <div class="right">
+                            <button ws-send data-action="spectate-game" data-game="{{ .Id }}">
+                                {{ $lang.Loc "SpectateAction" }}
+                            </button>
+                        </div>
+                    </div>
+                </div>
+            </div>
+        {{- end }}
     </div>
 {{- end }}
\ No newline at end of file
@@ -58,6 +58,20 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 			break
 		}
 
+		//
+		// stop spectating ( if spectating, any other action is rejected )
+		//
+
+		if user.IsSpectating() {
+			switch jsonMessage.Action {
+			case "leave-game":
+				err = s.HandleStopSpectating(user)
+			default:
+				err = share_model.ErrSpectatorAction
+			}
+			break
+		}
+
 		//
 		// create or join game ( if not playing )
 		//
@@ -72,9 +86,11 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 
 			switch jsonMessage.Action {
 			case "create-game":
-				err = s.HandleCreateGame(user)
+				err = s.HandleCreateGame(user, jsonMessage.GameOptions(), jsonMessage.GameSettings())
 			case "join-game":
 				err = s.HandleJoinGame(jsonMessage.GameId(), user)
+			case "spectate-game":
+				err = s.HandleSpectateGame(jsonMessage.GameId(), user)
 			default:
 				err = share_model.ErrInvalidAction
 			}
@@ -98,14 +114,24 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 		}()
 
 		switch jsonMessage.Action {
+		case "update-settings":
+			err = s.HandleUpdateSettings(player, jsonMessage.GameOptions())
+		case "kick-player":
+			err = s.HandleKickPlayer(player, jsonMessage.PlayerId())
+		case "set-ready":
+			err = s.HandleSetReady(player, jsonMessage.Ready())
 		case "start-game":
 			err = s.HandleStartGame(player)
+		case "add-bot":
+			err = s.HandleAddBot(player)
 		case "select-card":
-			err = s.HandleSelectCard(player, jsonMessage.CardNumber())
+			err = s.HandleSelectCard(player, jsonMessage.Version(), jsonMessage.CardNumber())
 		case "play-card":
-			err = s.HandlePlayCard(player, jsonMessage.DiscardNumber())
+			err = s.HandlePlayCard(player, jsonMessage.Version(), jsonMessage.DiscardNumber())
 		case "leave-game":
 			err = s.HandleLeaveGame(player)
+		case "rematch":
+			err = s.HandleRematch(player)
 		default:
 			err = share_model.ErrInvalidAction
 		}
@@ -121,14 +147,47 @@ type JsonMessage struct {
 	Action           string `json:"action,omitempty"`
 	PlayerName       string `json:"name,omitempty"`
 	GameIdStr        string `json:"game,omitempty"`
+	PlayerIdStr      string `json:"player,omitempty"`
+	ReadyStr         string `json:"ready,omitempty"`
 	CardNumberStr    string `json:"card,omitempty"`
 	DiscardNumberStr string `json:"discard,omitempty"`
+	VersionStr       string `json:"version,omitempty"`
+	TurnTimeoutStr   string `json:"turn-timeout,omitempty"`
+	PrivateStr       string `json:"private,omitempty"`
+	ReadyCheckStr    string `json:"ready-check,omitempty"`
+	DifficultyStr    string `json:"difficulty,omitempty"`
 }
 
 func (j *JsonMessage) GameId() share_model.GameId {
 	return share_model.GameId(j.GameIdStr)
 }
 
+func (j *JsonMessage) PlayerId() share_model.PlayerId {
+	return share_model.PlayerId(j.PlayerIdStr)
+}
+
+func (j *JsonMessage) Ready() bool {
+	return util.ToBool(j.ReadyStr)
+}
+
+func (j *JsonMessage) Version() int {
+	return util.ToInt(j.VersionStr)
+}
+
+func (j *JsonMessage) GameOptions() share_model.GameOptions {
+	return share_model.GameOptions{
+		TurnTimeout: time.Duration(util.ToInt(j.TurnTimeoutStr)) * time.Second,
+		Private:     util.ToBool(j.PrivateStr),
+		ReadyCheck:  util.ToBool(j.ReadyCheckStr),
+	}
+}
+
+func (j *JsonMessage) GameSettings() model.Settings {
+	return model.Settings{
+		Difficulty: j.DifficultyStr,
+	}
+}
+
 func (j *JsonMessage) CardNumber() int {
 	return util.ToInt(j.CardNumberStr)
 }
@@ -7,7 +7,7 @@ import (
 
 type CardDeck []Card
 
-func NewDrawCardDeck() CardDeck {
+func NewDrawCardDeck(random *rand.Rand) CardDeck {
 	deck := CardDeck{}
 	for i := 1; i <= Card_NbPerValue; i++ {
 		for _, color := range CardColors {
@@ -16,7 +16,7 @@ func NewDrawCardDeck() CardDeck {
 			}
 		}
 	}
-	deck.Shuffle()
+	deck.Shuffle(random)
 	return deck
 }
 
@@ -52,8 +52,8 @@ func (d *CardDeck) Add(card Card) {
 	*d = append(*d, card)
 }
 
-func (d *CardDeck) Shuffle() {
-	rand.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
+func (d *CardDeck) Shuffle(random *rand.Rand) {
+	random.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
 }
 
 func (d CardDeck) Labels() string {
@@ -0,0 +1,121 @@
+package model
+
+// //////////////////////////////////////////////////
+// difficulty
+
+type Difficulty string
+
+const (
+	Difficulty_Easy   Difficulty = "easy"
+	Difficulty_Normal Difficulty = "normal"
+	Difficulty_Hard   Difficulty = "hard"
+	Difficulty_Expert Difficulty = "expert"
+)
+
+var (
+	Difficulties = []Difficulty{
+		Difficulty_Easy,
+		Difficulty_Normal,
+		Difficulty_Hard,
+		Difficulty_Expert,
+	}
+)
+
+// DifficultyRules are the rules changed by a difficulty level.
+type DifficultyRules struct {
+	// NbVisibleMission is the number of mission slots filled during the game ( at most NbMission ).
+	NbVisibleMission int
+	// NbCardBonus is added to the number of cards dealt to each player.
+	NbCardBonus int
+	// Hints tells whether players may tell each other about their hand.
+	Hints bool
+	// ExcludedMissions lists the mission templates left out of the mission deck.
+	ExcludedMissions []string
+}
+
+var difficultyRules = map[Difficulty]DifficultyRules{
+	Difficulty_Easy: {
+		NbVisibleMission: 4,
+		NbCardBonus:      1,
+		Hints:            true,
+		ExcludedMissions: []string{
+			"mission-two-even-separated-by-one",
+			"mission-all-different",
+			"mission-four-values-in-a-row",
+			"mission-three-ordered-values",
+		},
+	},
+	Difficulty_Normal: {
+		NbVisibleMission: 4,
+		NbCardBonus:      0,
+		Hints:            true,
+	},
+	Difficulty_Hard: {
+		NbVisibleMission: 3,
+		NbCardBonus:      0,
+		Hints:            false,
+	},
+	Difficulty_Expert: {
+		NbVisibleMission: 2,
+		NbCardBonus:      -1,
+		Hints:            false,
+	},
+}
+
+// DifficultyFromString falls back to the normal difficulty for an empty or unknown value.
+func DifficultyFromString(value string) Difficulty {
+	difficulty := Difficulty(value)
+	if _, found := difficultyRules[difficulty]; found {
+		return difficulty
+	}
+	return Difficulty_Normal
+}
+
+func (d Difficulty) Rules() DifficultyRules {
+	if rules, found := difficultyRules[d]; found {
+		return rules
+	}
+	return difficultyRules[Difficulty_Normal]
+}
+
+func (d Difficulty) NbVisibleMission() int {
+	return d.Rules().NbVisibleMission
+}
+
+// NbCard is the number of cards dealt to each player.
+func (d Difficulty) NbCard(nbPlayer int) int {
+	return Hand_NbCardPerNbPlayer[nbPlayer] + d.Rules().NbCardBonus
+}
+
+func (d Difficulty) AllowsHints() bool {
+	return d.Rules().Hints
+}
+
+func (d Difficulty) IncludesMission(mission Mission) bool {
+	tpl, _ := mission.GetTpl()
+	for _, excluded := range d.Rules().ExcludedMissions {
+		if tpl == excluded {
+			return false
+		}
+	}
+	return true
+}
+
+func (d Difficulty) LocKey() string {
+	switch d {
+	case Difficulty_Easy:
+		return "DifficultyEasy"
+	case Difficulty_Hard:
+		return "DifficultyHard"
+	case Difficulty_Expert:
+		return "DifficultyExpert"
+	}
+	return "DifficultyNormal"
+}
+
+func (d Difficulty) HintsLocKey() string {
+	if d.AllowsHints() {
+		return "HintsAllowed"
+	}
+	return "HintsForbidden"
+}
@@ -33,4 +33,8 @@ var (
 	ErrInvalidCardNumber    = fmt.Errorf("invalid card number")
 	ErrInvalidDiscardNumber = fmt.Errorf("invalid discard number")
 	ErrNoSelectedCard       = fmt.Errorf("no selected card")
+	ErrUnknownMission       = fmt.Errorf("unknown mission")
+	ErrInvalidMission       = fmt.Errorf("invalid mission")
+	ErrDuplicateMission     = fmt.Errorf("duplicate mission")
+	ErrImpossibleMission    = fmt.Errorf("impossible mission")
 )
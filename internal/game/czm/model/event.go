@@ -0,0 +1,24 @@
+package model
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// event type
+
+const (
+	EventType_SelectCard share_model.EventType = "select-card"
+	EventType_PlayCard   share_model.EventType = "play-card"
+)
+
+// //////////////////////////////////////////////////
+// event payloads
+
+type SelectCardPayload struct {
+	CardNumber int `json:"card-number"`
+}
+
+type PlayCardPayload struct {
+	DiscardNumber int `json:"discard-number"`
+}
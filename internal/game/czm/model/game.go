@@ -9,20 +9,37 @@ const (
 	Game_MaxPlayer = 4
 )
 
-func NewGame() *Game {
+// Settings are chosen when creating a game ( zero values mean the game defaults ).
+type Settings struct {
+	Difficulty string `json:"difficulty,omitempty"`
+}
+
+// NewGameFromSetup applies the game settings to a new game.
+func NewGameFromSetup(setup share_model.GameSetup) (*Game, error) {
+	settings := Settings{}
+	if err := setup.DecodeSettings(&settings); err != nil {
+		return nil, err
+	}
+	return NewGame(setup, DifficultyFromString(settings.Difficulty)), nil
+}
+
+func NewGame(setup share_model.GameSetup, difficulty Difficulty) *Game {
+	shareGame := share_model.NewGame[*Player](setup, Game_MinPlayer, Game_MaxPlayer)
 	game := &Game{
-		Game:         share_model.NewGame[*Player](Game_MinPlayer, Game_MaxPlayer),
-		DrawCardDeck: NewDrawCardDeck(),
+		BaseGame:     shareGame,
+		Difficulty:   difficulty,
+		DrawCardDeck: NewDrawCardDeck(shareGame.Random()),
 		DiscardCardDecks: [NbCardDeck]CardDeck{
 			NewDiscardCardDeck(),
 			NewDiscardCardDeck(),
 			NewDiscardCardDeck(),
 			NewDiscardCardDeck(),
 		},
-		SelectedCardNumber: 0,
-		DrawMissionDeck:    NewDrawMissionDeck(),
-		Missions:           [NbMission]Mission{},
-		DiscardMissionDeck: NewDiscardMissionDeck(),
+		SelectedCardNumber:    0,
+		DrawMissionDeck:       NewDrawMissionDeck(shareGame.Random(), difficulty),
+		Missions:              [NbMission]Mission{},
+		DiscardMissionDeck:    NewDiscardMissionDeck(),
+		ValidatedMissionIndex: -1,
 	}
 	return game
 }
@@ -41,10 +58,20 @@ const (
 	NbMission  = 4
 )
 
+var (
+	// Hand_NbCardPerNbPlayer is the number of cards dealt to each player.
+	Hand_NbCardPerNbPlayer = map[int]int{
+		2: 5,
+		3: 4,
+		4: 3,
+	}
+)
+
 type TopMissions [NbMission]Mission
 
 type Game struct {
-	share_model.Game[*Player]
+	*share_model.BaseGame[*Player]
+	Difficulty            Difficulty
 	DrawCardDeck          CardDeck
 	DiscardCardDecks      [NbCardDeck]CardDeck
 	SelectedCardNumber    int
@@ -69,6 +96,22 @@ func (g *Game) IsCardSelected(playerId share_model.PlayerId, cardNumber int) boo
 	return false
 }
 
+// DealHands deals a hand to each player in playing order.
+func (g *Game) DealHands() error {
+	nbCard := g.Difficulty.NbCard(g.NbPlayer())
+	for index := range g.Order() {
+		player := g.OrderedPlayer(index)
+		for i := 0; i < nbCard; i++ {
+			card, err := g.DrawCardDeck.Draw()
+			if err != nil {
+				return err
+			}
+			player.WithCard(card)
+		}
+	}
+	return nil
+}
+
 func (g *Game) GetTopCards() TopCards {
 	topCards := TopCards{}
 	for index, discardDeck := range g.DiscardCardDecks {
@@ -104,26 +147,99 @@ func (g *Game) HasGoldMedal() bool {
 	return len(g.DiscardMissionDeck) > 23
 }
 
-func (g *Game) HasWinner() (bool, share_model.PlayerId) {
-	// for x := 1; x <= 3; x++ {
-	// 	same, symbol := g.HasSameSymbol(g.Rows[1].Cells[x], g.Rows[2].Cells[x], g.Rows[3].Cells[x])
-	// 	if same {
-	// 		return true, g.GetPlayerIdFromRune(symbol)
-	// 	}
-	// }
-	// for y := 1; y <= 3; y++ {
-	// 	same, symbol := g.HasSameSymbol(g.Rows[y].Cells[1], g.Rows[y].Cells[2], g.Rows[y].Cells[3])
-	// 	if same {
-	// 		return true, g.GetPlayerIdFromRune(symbol)
-	// 	}
-	// }
-	// same, symbol := g.HasSameSymbol(g.Rows[1].Cells[1], g.Rows[2].Cells[2], g.Rows[3].Cells[3])
-	// if same {
-	// 	return true, g.GetPlayerIdFromRune(symbol)
-	// }
-	// same, symbol = g.HasSameSymbol(g.Rows[1].Cells[3], g.Rows[2].Cells[2], g.Rows[3].Cells[1])
-	// if same {
-	// 	return true, g.GetPlayerIdFromRune(symbol)
-	// }
-	return false, ""
+// EarnedMedal is the best medal earned by the team from the missions completed so far.
+func (g *Game) EarnedMedal() Medal {
+	switch {
+	case g.HasGoldMedal():
+		return Medal_Gold
+	case g.HasSilverMedal():
+		return Medal_Silver
+	case g.HasBronzeMedal():
+		return Medal_Bronze
+	}
+	return Medal_None
+}
+
+func (g *Game) HasMedal() bool {
+	return !g.Medal.IsNone()
+}
+
+// //////////////////////////////////////////////////
+// missions
+
+// DrawMissions fills the empty visible mission slots from the mission deck.
+// Once the mission deck is exhausted, the remaining slots are left empty.
+func (g *Game) DrawMissions() {
+	for index, mission := range g.Missions {
+		if mission != nil || index >= g.Difficulty.NbVisibleMission() {
+			continue
+		}
+		newMission, err := g.DrawMissionDeck.Draw()
+		if err != nil {
+			// the mission deck is exhausted: the remaining slots stay empty
+			return
+		}
+		g.Missions[index] = newMission
+	}
+}
+
+// HasMissionsLeft tells whether a mission is still visible or in the mission deck.
+func (g *Game) HasMissionsLeft() bool {
+	if g.DrawMissionDeck.Size() > 0 {
+		return true
+	}
+	for _, mission := range g.Missions {
+		if mission != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMissions completes the first mission fulfilled by the top cards and replaces it from the mission deck.
+// It cannot fail, so that a card already played never leaves the game half played.
+func (g *Game) ValidateMissions() {
+	topCards := g.GetTopCards()
+
+	g.ValidatedMissionIndex = -1
+	for index, mission := range g.Missions {
+		if mission == nil {
+			continue
+		}
+		if mission.IsCompleted(topCards) {
+			g.ValidatedMissionIndex = index
+			g.DiscardMissionDeck.Discard(mission)
+			g.Missions[index] = nil
+			g.DrawMissions()
+			return
+		}
+	}
+}
+
+// //////////////////////////////////////////////////
+// end of game
+
+// SetCompleted ends the game as a team win once every mission is completed.
+func (g *Game) SetCompleted() {
+	g.Medal = g.EarnedMedal()
+	g.SetWinners(g.playerIds()...)
+}
+
+// SetExhausted ends the game once the draw deck is exhausted with missions left:
+// the team still wins when it earned a medal on the way, and loses otherwise.
+func (g *Game) SetExhausted() {
+	g.Medal = g.EarnedMedal()
+	if g.HasMedal() {
+		g.SetWinners(g.playerIds()...)
+	} else {
+		g.SetLoosers(g.playerIds()...)
+	}
+}
+
+func (g *Game) playerIds() []share_model.PlayerId {
+	playerIds := make([]share_model.PlayerId, 0, g.NbPlayer())
+	for _, player := range g.Players() {
+		playerIds = append(playerIds, player.Id())
+	}
+	return playerIds
 }
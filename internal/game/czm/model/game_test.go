@@ -0,0 +1,191 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestValidateMissions(t *testing.T) {
+
+	type TestCase struct {
+		cards            string
+		drawMissions     MissionDeck
+		wantIndex        int
+		wantNbCompleted  int
+		wantFirstMission Mission
+	}
+
+	sum10 := NewSumMission(10)
+	allOdd := NewAllOddMission()
+
+	testCases := map[string]TestCase{
+		"not-completed": {
+			cards:            "R1 G2 B3 Y5",
+			drawMissions:     MissionDeck{allOdd},
+			wantIndex:        -1,
+			wantNbCompleted:  0,
+			wantFirstMission: sum10,
+		},
+		"completed-and-replaced": {
+			cards:            "R1 G2 B3 Y4",
+			drawMissions:     MissionDeck{allOdd},
+			wantIndex:        0,
+			wantNbCompleted:  1,
+			wantFirstMission: allOdd,
+		},
+		"completed-with-empty-mission-deck": {
+			cards:            "R1 G2 B3 Y4",
+			drawMissions:     MissionDeck{},
+			wantIndex:        0,
+			wantNbCompleted:  1,
+			wantFirstMission: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := NewGame(share_model.GameSetup{Id: "G1"}, Difficulty_Normal)
+			game.Missions = [NbMission]Mission{sum10, NewAllBigMission(), NewAllBigMission(), NewAllBigMission()}
+			game.DrawMissionDeck = tc.drawMissions
+			for index, card := range TopCardsFromString(tc.cards) {
+				game.DiscardCardDecks[index].Add(card)
+			}
+
+			game.ValidateMissions()
+			require.Equal(t, tc.wantIndex, game.GetValidatedMissionIndex())
+			require.Equal(t, tc.wantNbCompleted, game.DiscardMissionDeck.Size())
+			require.Equal(t, tc.wantFirstMission, game.Missions[0])
+		})
+	}
+}
+
+func TestHasMissionsLeft(t *testing.T) {
+
+	type TestCase struct {
+		drawMissions MissionDeck
+		missions     [NbMission]Mission
+		want         bool
+	}
+
+	allBig := NewAllBigMission()
+
+	testCases := map[string]TestCase{
+		"missions-in-deck": {drawMissions: MissionDeck{allBig}, want: true},
+		"visible-mission":  {drawMissions: MissionDeck{}, missions: [NbMission]Mission{nil, nil, allBig, nil}, want: true},
+		"no-mission-left":  {drawMissions: MissionDeck{}, want: false},
+		"deck-and-visible": {drawMissions: MissionDeck{allBig}, missions: [NbMission]Mission{allBig, allBig, allBig, allBig}, want: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := NewGame(share_model.GameSetup{Id: "G1"}, Difficulty_Normal)
+			game.DrawMissionDeck = tc.drawMissions
+			game.Missions = tc.missions
+			require.Equal(t, tc.want, game.HasMissionsLeft())
+		})
+	}
+}
+
+func TestDealHands(t *testing.T) {
+	testCases := map[int]int{
+		2: 5,
+		3: 4,
+		4: 3,
+	}
+	for nbPlayer, wantNbCard := range testCases {
+		game := NewGame(share_model.GameSetup{Id: "G1"}, Difficulty_Normal)
+		for i := 1; i <= nbPlayer; i++ {
+			game.AttachPlayer(NewPlayerFromUser(game.Id(), share_model.NewUser(share_model.UserId(fmt.Sprintf("U%d", i)))))
+		}
+		game.SetRandomOrder()
+		nbDrawCard := game.DrawCardDeck.Size()
+
+		require.NoError(t, game.DealHands())
+		for _, player := range game.Players() {
+			require.Len(t, player.Cards, wantNbCard, "%d players", nbPlayer)
+		}
+		require.Equal(t, nbDrawCard-nbPlayer*wantNbCard, game.DrawCardDeck.Size(), "%d players", nbPlayer)
+	}
+}
+
+func TestEarnedMedal(t *testing.T) {
+	testCases := map[int]Medal{
+		0:  Medal_None,
+		15: Medal_None,
+		16: Medal_Bronze,
+		19: Medal_Bronze,
+		20: Medal_Silver,
+		23: Medal_Silver,
+		24: Medal_Gold,
+		50: Medal_Gold,
+	}
+	for nbCompleted, wantMedal := range testCases {
+		game := NewGame(share_model.GameSetup{Id: "G1"}, Difficulty_Normal)
+		game.DiscardMissionDeck = NewMissionDeck()[:nbCompleted]
+		require.Equal(t, wantMedal, game.EarnedMedal(), "%d completed missions", nbCompleted)
+	}
+}
+
+func TestDifficulty(t *testing.T) {
+
+	type TestCase struct {
+		difficulty       string
+		wantDifficulty   Difficulty
+		wantNbMission    int
+		wantNbCard       int
+		wantNbAllMission int
+	}
+
+	testCases := map[string]TestCase{
+		"default": {difficulty: "", wantDifficulty: Difficulty_Normal, wantNbMission: 4, wantNbCard: 5, wantNbAllMission: 50},
+		"unknown": {difficulty: "insane", wantDifficulty: Difficulty_Normal, wantNbMission: 4, wantNbCard: 5, wantNbAllMission: 50},
+		"easy":    {difficulty: "easy", wantDifficulty: Difficulty_Easy, wantNbMission: 4, wantNbCard: 6, wantNbAllMission: 46},
+		"normal":  {difficulty: "normal", wantDifficulty: Difficulty_Normal, wantNbMission: 4, wantNbCard: 5, wantNbAllMission: 50},
+		"hard":    {difficulty: "hard", wantDifficulty: Difficulty_Hard, wantNbMission: 3, wantNbCard: 5, wantNbAllMission: 50},
+		"expert":  {difficulty: "expert", wantDifficulty: Difficulty_Expert, wantNbMission: 2, wantNbCard: 4, wantNbAllMission: 50},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			settings, err := share_model.EncodeSettings(Settings{Difficulty: tc.difficulty})
+			require.NoError(t, err)
+			game, err := NewGameFromSetup(share_model.GameSetup{Id: "G1", Settings: settings})
+			require.NoError(t, err)
+			game.AttachPlayer(NewPlayerFromUser(game.Id(), share_model.NewUser("U1")))
+			game.AttachPlayer(NewPlayerFromUser(game.Id(), share_model.NewUser("U2")))
+			game.SetRandomOrder()
+			require.Equal(t, tc.wantDifficulty, game.Difficulty)
+			require.Equal(t, tc.wantNbAllMission, game.DrawMissionDeck.Size())
+
+			require.NoError(t, game.DealHands())
+			for _, player := range game.Players() {
+				require.Len(t, player.Cards, tc.wantNbCard)
+			}
+
+			game.DrawMissions()
+			nbMission := 0
+			for _, mission := range game.Missions {
+				if mission != nil {
+					require.True(t, game.Difficulty.IncludesMission(mission))
+					nbMission++
+				}
+			}
+			require.Equal(t, tc.wantNbMission, nbMission)
+			require.Equal(t, tc.wantNbAllMission-tc.wantNbMission, game.DrawMissionDeck.Size())
+
+			snapshot, err := NewGameSnapshot(game).ToGame()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantDifficulty, snapshot.Difficulty)
+		})
+	}
+}
+
+func TestNewGameFromSetupInvalidSettings(t *testing.T) {
+
+	_, err := NewGameFromSetup(share_model.GameSetup{Id: "G1", Settings: []byte(`{"difficulty":1}`)})
+	require.ErrorIs(t, err, share_model.ErrInvalidSettings)
+}
@@ -3,7 +3,24 @@ package model
 type Medal string
 
 const (
+	Medal_None   Medal = ""
 	Medal_Bronze Medal = "bronze"
 	Medal_Silver Medal = "silver"
 	Medal_Gold   Medal = "gold"
 )
+
+func (m Medal) IsNone() bool {
+	return m == Medal_None
+}
+
+func (m Medal) LocKey() string {
+	switch m {
+	case Medal_Bronze:
+		return "MedalBronze"
+	case Medal_Silver:
+		return "MedalSilver"
+	case Medal_Gold:
+		return "MedalGold"
+	}
+	return "MedalNone"
+}
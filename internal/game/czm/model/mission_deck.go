@@ -1,68 +1,30 @@
 package model
 
 import (
+	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
 )
 
 type MissionDeck []Mission
 
-func NewDrawMissionDeck() MissionDeck {
+// NewDrawMissionDeck shuffles the missions included at the given difficulty.
+func NewDrawMissionDeck(random *rand.Rand, difficulty Difficulty) MissionDeck {
 	deck := MissionDeck{}
-
-	for _, color := range CardColors {
-		deck.Add(NewTwoColorsNextToEachOtherMission(color))
-		deck.Add(NewTwoColorsSeparatedByOneMission(color))
-		deck.Add(NewTwoColorsSeparatedMission(color))
-		deck.Add(NewThreeColorsMission(color))
+	for _, mission := range NewMissionDeck() {
+		if difficulty.IncludesMission(mission) {
+			deck.Add(mission)
+		}
 	}
+	deck.Shuffle(random)
+	return deck
+}
 
-	deck.Add(NewTwoEvenSeparatedByOneMission())
-
-	deck.Add(NewColorDoubleOfColorMission(CardColor_Green, CardColor_Blue))
-	deck.Add(NewColorDoubleOfColorMission(CardColor_Blue, CardColor_Red))
-	deck.Add(NewColorDoubleOfColorMission(CardColor_Red, CardColor_Yellow))
-	deck.Add(NewColorDoubleOfColorMission(CardColor_Yellow, CardColor_Green))
-
-	deck.Add(NewColorEqualColorMission(CardColor_Green, CardColor_Blue))
-	deck.Add(NewColorEqualColorMission(CardColor_Blue, CardColor_Red))
-	deck.Add(NewColorEqualColorMission(CardColor_Red, CardColor_Yellow))
-	deck.Add(NewColorEqualColorMission(CardColor_Yellow, CardColor_Green))
-
-	deck.Add(NewColorSumMission(2, CardColor_Yellow))
-	deck.Add(NewColorSumMission(3, CardColor_Blue))
-	deck.Add(NewColorSumMission(4, CardColor_Red))
-	deck.Add(NewColorSumMission(6, CardColor_Green))
-	deck.Add(NewColorSumMission(7, CardColor_Green))
-	deck.Add(NewColorSumMission(9, CardColor_Blue))
-	deck.Add(NewColorSumMission(10, CardColor_Red))
-	deck.Add(NewColorSumMission(11, CardColor_Yellow))
-
-	deck.Add(NewSumMission(10))
-	deck.Add(NewSumMission(15))
-	deck.Add(NewSumMission(18))
-	deck.Add(NewSumMission(20))
-
-	deck.Add(NewAllDifferentMission())
-	deck.Add(NewAllDifferentColorMission())
-	deck.Add(NewAllDifferentValueMission())
-
-	deck.Add(NewAllSmallMission())
-	deck.Add(NewAllBigMission())
-
-	deck.Add(NewAllEvenMission())
-	deck.Add(NewAllOddMission())
-
-	deck.Add(NewAllTwoColorsMission(CardColor_Blue, CardColor_Yellow))
-	deck.Add(NewAllTwoColorsMission(CardColor_Yellow, CardColor_Green))
-	deck.Add(NewAllTwoColorsMission(CardColor_Green, CardColor_Red))
-	deck.Add(NewAllTwoColorsMission(CardColor_Red, CardColor_Blue))
-
-	deck.Add(NewFourValuesInARowMission())
-	deck.Add(NewThreeOrderedValuesMission())
-
-	deck.Shuffle()
-
+// NewMissionDeck returns every mission of the game in a stable ( unshuffled ) order, as defined in missions.yaml.
+func NewMissionDeck() MissionDeck {
+	deck := make(MissionDeck, len(missionDeck))
+	copy(deck, missionDeck)
 	return deck
 }
 
@@ -98,12 +60,32 @@ func (d *MissionDeck) Draw() (Mission, error) {
 	return mission, nil
 }
 
-func (d MissionDeck) Discard(mission Mission) {
-	d = append(d, mission)
+func (d *MissionDeck) Discard(mission Mission) {
+	d.Add(mission)
+}
+
+func (d *MissionDeck) Shuffle(random *rand.Rand) {
+	random.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
 }
 
-func (d *MissionDeck) Shuffle() {
-	rand.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
+func (d MissionDeck) Ids() []string {
+	ids := make([]string, 0, len(d))
+	for _, mission := range d {
+		ids = append(ids, MissionId(mission))
+	}
+	return ids
+}
+
+func NewMissionDeckFromIds(ids []string) (MissionDeck, error) {
+	deck := MissionDeck{}
+	for _, id := range ids {
+		mission, err := MissionFromId(id)
+		if err != nil {
+			return nil, err
+		}
+		deck.Add(mission)
+	}
+	return deck, nil
 }
 
 func (d MissionDeck) Labels() string {
@@ -114,3 +96,43 @@ func (d MissionDeck) Labels() string {
 	}
 	return strings.Join(labels, " ")
 }
+
+// //////////////////////////////////////////////////
+// mission id
+
+// MissionId identifies a mission by its template name and arguments ( e.g. "mission-sum:sum=10" ).
+func MissionId(mission Mission) string {
+	if mission == nil {
+		return ""
+	}
+	name, args := mission.GetTpl()
+	keys := make([]string, 0, len(args))
+	for key := range args {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, name)
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, args[key]))
+	}
+	return strings.Join(parts, ":")
+}
+
+var missionsById = func() map[string]Mission {
+	missions := make(map[string]Mission)
+	for _, mission := range NewMissionDeck() {
+		missions[MissionId(mission)] = mission
+	}
+	return missions
+}()
+
+func MissionFromId(id string) (Mission, error) {
+	if id == "" {
+		return nil, nil
+	}
+	if mission, ok := missionsById[id]; ok {
+		return mission, nil
+	}
+	return nil, ErrUnknownMission
+}
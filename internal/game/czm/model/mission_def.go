@@ -0,0 +1,479 @@
+package model
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// //////////////////////////////////////////////////
+// mission definition
+
+// MissionDef describes a mission card as rules over the top cards: every rule it sets must hold.
+//
+// A pattern has one token per top card, from left to right. A token lists alternatives separated by "|",
+// each one being "*" ( any card ), a color ( "R", "G", "B", "Y" ) or a value kind ( "E" even, "O" odd, "S" small, "L" large ),
+// optionally negated with "!". For instance "R !R|E * *" reads "red first card, then a non-red or even card".
+type MissionDef struct {
+	// Tpl is the template used to render the mission.
+	Tpl string `yaml:"tpl"`
+	// Args are given to the template, and identify the mission with Tpl.
+	Args map[string]any `yaml:"args,omitempty"`
+	// Patterns are alternatives: at least one of them must match the top cards.
+	Patterns []string `yaml:"patterns,omitempty"`
+	// Sum of the values of the top cards of the given colors ( all cards if none ).
+	Sum *SumRule `yaml:"sum,omitempty"`
+	// Ratio between the sums of the values of two groups of colors.
+	Ratio *RatioRule `yaml:"ratio,omitempty"`
+	// Distinct lists the card properties ( "color", "value" ) that must all differ.
+	Distinct []string `yaml:"distinct,omitempty"`
+	// Run of consecutive values.
+	Run *RunRule `yaml:"run,omitempty"`
+}
+
+// HasRules tells whether the definition sets at least one rule.
+func (d MissionDef) HasRules() bool {
+	return len(d.Patterns) != 0 || d.Sum != nil || d.Ratio != nil || len(d.Distinct) != 0 || d.Run != nil
+}
+
+type SumRule struct {
+	Colors string `yaml:"colors,omitempty"`
+	Equals int    `yaml:"equals"`
+}
+
+// RatioRule holds when the sum of Colors is Factor times the sum of Of, both sums being non zero.
+type RatioRule struct {
+	Colors string `yaml:"colors"`
+	Of     string `yaml:"of"`
+	Factor int    `yaml:"factor"`
+}
+
+// RunRule holds when Length top cards have consecutive values:
+// in any position, or side by side in ascending or descending order when InPlace.
+type RunRule struct {
+	Length  int  `yaml:"length"`
+	InPlace bool `yaml:"in-place,omitempty"`
+}
+
+// //////////////////////////////////////////////////
+// mission from definition
+
+type cardMatcher func(card Card) bool
+
+type defMission struct {
+	def      MissionDef
+	patterns [][NbCardDeck]cardMatcher
+	sumColors,
+	ratioColors,
+	ratioOf []CardColor
+}
+
+// NewMissionFromDef compiles a mission definition.
+func NewMissionFromDef(def MissionDef) (Mission, error) {
+	if def.Tpl == "" {
+		return nil, fmt.Errorf("%w: missing tpl", ErrInvalidMission)
+	}
+	if !def.HasRules() {
+		return nil, fmt.Errorf("%w: %s: no rule", ErrInvalidMission, def.Tpl)
+	}
+	if len(def.Args) == 0 {
+		def.Args = nil
+	}
+
+	mission := &defMission{
+		def: def,
+	}
+	for _, value := range def.Patterns {
+		pattern, err := parsePattern(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidMission, def.Tpl, err)
+		}
+		mission.patterns = append(mission.patterns, pattern)
+	}
+
+	var err error
+	if def.Sum != nil {
+		if mission.sumColors, err = parseColors(def.Sum.Colors); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidMission, def.Tpl, err)
+		}
+	}
+	if def.Ratio != nil {
+		if mission.ratioColors, err = parseColors(def.Ratio.Colors); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidMission, def.Tpl, err)
+		}
+		if mission.ratioOf, err = parseColors(def.Ratio.Of); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidMission, def.Tpl, err)
+		}
+		if len(mission.ratioColors) == 0 || len(mission.ratioOf) == 0 || def.Ratio.Factor < 1 {
+			return nil, fmt.Errorf("%w: %s: invalid ratio", ErrInvalidMission, def.Tpl)
+		}
+	}
+	for _, property := range def.Distinct {
+		if property != "color" && property != "value" {
+			return nil, fmt.Errorf("%w: %s: invalid distinct property %q", ErrInvalidMission, def.Tpl, property)
+		}
+	}
+	if def.Run != nil && (def.Run.Length < 2 || def.Run.Length > NbCardDeck) {
+		return nil, fmt.Errorf("%w: %s: invalid run length %d", ErrInvalidMission, def.Tpl, def.Run.Length)
+	}
+	return mission, nil
+}
+
+func (m *defMission) GetTpl() (string, map[string]any) {
+	return m.def.Tpl, m.def.Args
+}
+
+func (m *defMission) IsCompleted(cards TopCards) bool {
+	return m.matchPatterns(cards) &&
+		m.matchSum(cards) &&
+		m.matchRatio(cards) &&
+		m.matchDistinct(cards) &&
+		m.matchRun(cards)
+}
+
+func (m *defMission) matchPatterns(cards TopCards) bool {
+	if len(m.patterns) == 0 {
+		return true
+	}
+	for _, pattern := range m.patterns {
+		matched := true
+		for index, matchFn := range pattern {
+			if !matchFn(cards[index]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *defMission) matchSum(cards TopCards) bool {
+	if m.def.Sum == nil {
+		return true
+	}
+	sum := cards.Sum()
+	if len(m.sumColors) != 0 {
+		sum = cards.ColorSum(m.sumColors...)
+	}
+	return sum != 0 && sum == m.def.Sum.Equals
+}
+
+func (m *defMission) matchRatio(cards TopCards) bool {
+	if m.def.Ratio == nil {
+		return true
+	}
+	sum := cards.ColorSum(m.ratioColors...)
+	sumOf := cards.ColorSum(m.ratioOf...)
+	return sum != 0 && sumOf != 0 && sum == m.def.Ratio.Factor*sumOf
+}
+
+func (m *defMission) matchDistinct(cards TopCards) bool {
+	for _, property := range m.def.Distinct {
+		values := make(map[int]struct{})
+		for _, card := range cards {
+			switch property {
+			case "color":
+				values[int(card.Color())] = struct{}{}
+			case "value":
+				values[int(card.Value())] = struct{}{}
+			}
+		}
+		if len(values) != len(cards) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *defMission) matchRun(cards TopCards) bool {
+	if m.def.Run == nil {
+		return true
+	}
+	length := m.def.Run.Length
+
+	if m.def.Run.InPlace {
+		for start := 0; start+length <= len(cards); start++ {
+			for _, step := range []CardValue{1, -1} {
+				run := true
+				for index := start + 1; index < start+length; index++ {
+					if cards[index].Value() != cards[index-1].Value()+step {
+						run = false
+						break
+					}
+				}
+				if run {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	values := make([]CardValue, 0, len(cards))
+	for _, card := range cards {
+		values = append(values, card.Value())
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	for start := 0; start+length <= len(values); start++ {
+		run := true
+		for index := start + 1; index < start+length; index++ {
+			if values[index] != values[index-1]+1 {
+				run = false
+				break
+			}
+		}
+		if run {
+			return true
+		}
+	}
+	return false
+}
+
+// //////////////////////////////////////////////////
+// pattern
+
+func parsePattern(value string) ([NbCardDeck]cardMatcher, error) {
+	pattern := [NbCardDeck]cardMatcher{}
+	tokens := strings.Fields(value)
+	if len(tokens) != NbCardDeck {
+		return pattern, fmt.Errorf("pattern %q: expecting %d tokens", value, NbCardDeck)
+	}
+	for index, token := range tokens {
+		alternatives := make([]cardMatcher, 0)
+		for _, atom := range strings.Split(token, "|") {
+			matchFn, err := parseAtom(atom)
+			if err != nil {
+				return pattern, fmt.Errorf("pattern %q: %w", value, err)
+			}
+			alternatives = append(alternatives, matchFn)
+		}
+		pattern[index] = func(card Card) bool {
+			for _, matchFn := range alternatives {
+				if matchFn(card) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return pattern, nil
+}
+
+func parseAtom(atom string) (cardMatcher, error) {
+	if negated, found := strings.CutPrefix(atom, "!"); found {
+		matchFn, err := parseAtom(negated)
+		if err != nil {
+			return nil, err
+		}
+		return func(card Card) bool { return !matchFn(card) }, nil
+	}
+	switch atom {
+	case "*":
+		return func(card Card) bool { return true }, nil
+	case "E":
+		return Card.IsEven, nil
+	case "O":
+		return Card.IsOdd, nil
+	case "S":
+		return Card.IsSmall, nil
+	case "L":
+		return Card.IsBig, nil
+	}
+	colors, err := parseColors(atom)
+	if err != nil || len(colors) != 1 {
+		return nil, fmt.Errorf("invalid token %q", atom)
+	}
+	color := colors[0]
+	return func(card Card) bool { return card.IsColor(color) }, nil
+}
+
+// parseColors reads color letters ( e.g. "RB" for red and blue ).
+func parseColors(value string) ([]CardColor, error) {
+	colors := make([]CardColor, 0, len(value))
+	for _, letter := range value {
+		found := false
+		for _, color := range CardColors {
+			if color.String() == string(letter) {
+				colors = append(colors, color)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid color %q", letter)
+		}
+	}
+	return colors, nil
+}
+
+// //////////////////////////////////////////////////
+// mission file
+
+// MissionFile lists mission definitions, and the templates they may be built from.
+//
+// A template holds the rules of its missions, written in YAML as in a mission definition,
+// with "{name}" placeholders replaced by the arguments of each mission: a color argument ( e.g. "red" ) is replaced by its letter ( e.g. "R" ).
+type MissionFile struct {
+	// Templates are rules by template name.
+	Templates map[string]string `yaml:"templates,omitempty"`
+	// Missions either set their own rules, or take the rules of their template.
+	Missions []MissionDef `yaml:"missions"`
+}
+
+var missionPlaceholder = regexp.MustCompile(`\{[\w-]+\}`)
+
+func ParseMissionFile(data []byte) (*MissionFile, error) {
+	file := &MissionFile{}
+	if err := yaml.UnmarshalStrict(data, file); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMission, err)
+	}
+	return file, nil
+}
+
+func ParseMissionDefs(data []byte) ([]MissionDef, error) {
+	file, err := ParseMissionFile(data)
+	if err != nil {
+		return nil, err
+	}
+	return file.MissionDefs()
+}
+
+// MissionDefs returns the missions of the file, the ones without rules being built from their template.
+func (f *MissionFile) MissionDefs() ([]MissionDef, error) {
+	defs := make([]MissionDef, 0, len(f.Missions))
+	for _, def := range f.Missions {
+		if !def.HasRules() {
+			var err error
+			if def, err = f.NewMissionDef(def.Tpl, def.Args); err != nil {
+				return nil, err
+			}
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// NewMissionDef fills the template with the arguments of a mission.
+func (f *MissionFile) NewMissionDef(tpl string, args map[string]any) (MissionDef, error) {
+	rules, found := f.Templates[tpl]
+	if !found {
+		return MissionDef{}, fmt.Errorf("%w: %s: unknown template", ErrInvalidMission, tpl)
+	}
+	for name, value := range args {
+		rules = strings.ReplaceAll(rules, "{"+name+"}", templateArg(value))
+	}
+	if placeholder := missionPlaceholder.FindString(rules); placeholder != "" {
+		return MissionDef{}, fmt.Errorf("%w: %s: missing argument %s", ErrInvalidMission, tpl, placeholder)
+	}
+
+	def := MissionDef{}
+	if err := yaml.UnmarshalStrict([]byte(rules), &def); err != nil {
+		return MissionDef{}, fmt.Errorf("%w: %s: %w", ErrInvalidMission, tpl, err)
+	}
+	def.Tpl = tpl
+	def.Args = args
+	return def, nil
+}
+
+// templateArg writes a color argument as its letter, and any other argument as is.
+func templateArg(value any) string {
+	for _, color := range CardColors {
+		if value == color.LabelColor() {
+			return color.String()
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+// //////////////////////////////////////////////////
+// embedded missions
+
+//go:embed missions.yaml
+var missionsYaml []byte
+
+// missionFile holds the embedded missions and templates.
+var missionFile = func() *MissionFile {
+	file, err := ParseMissionFile(missionsYaml)
+	if err != nil {
+		panic(err)
+	}
+	return file
+}()
+
+// missionDeck holds the embedded missions, validated when the program starts.
+var missionDeck = func() MissionDeck {
+	defs, err := missionFile.MissionDefs()
+	if err != nil {
+		panic(err)
+	}
+	deck, err := NewMissionDeckFromDefs(defs)
+	if err != nil {
+		panic(err)
+	}
+	return deck
+}()
+
+// NewMission builds a mission from an embedded template, e.g. "mission-color-sum" with { sum: 5, color: blue }.
+func NewMission(tpl string, args map[string]any) (Mission, error) {
+	def, err := missionFile.NewMissionDef(tpl, args)
+	if err != nil {
+		return nil, err
+	}
+	return NewMissionFromDef(def)
+}
+
+// NewMissionDeckFromDefs compiles mission definitions,
+// rejecting duplicated missions and missions no top cards can complete.
+func NewMissionDeckFromDefs(defs []MissionDef) (MissionDeck, error) {
+	deck := MissionDeck{}
+	ids := make(map[string]struct{})
+	for _, def := range defs {
+		mission, err := NewMissionFromDef(def)
+		if err != nil {
+			return nil, err
+		}
+		id := MissionId(mission)
+		if _, found := ids[id]; found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateMission, id)
+		}
+		ids[id] = struct{}{}
+		if !IsPossibleMission(mission) {
+			return nil, fmt.Errorf("%w: %s", ErrImpossibleMission, id)
+		}
+		deck.Add(mission)
+	}
+	return deck, nil
+}
+
+// IsPossibleMission tries every combination of top cards until one completes the mission.
+func IsPossibleMission(mission Mission) bool {
+	cards := make([]Card, 0, len(CardColors)*int(Card_MaxValue-Card_MinValue+1))
+	for _, color := range CardColors {
+		for value := Card_MinValue; value <= Card_MaxValue; value++ {
+			cards = append(cards, NewCard(value, color))
+		}
+	}
+
+	var topCards TopCards
+	var tryFn func(index int) bool
+	tryFn = func(index int) bool {
+		if index == len(topCards) {
+			return mission.IsCompleted(topCards)
+		}
+		for _, card := range cards {
+			topCards[index] = card
+			if tryFn(index + 1) {
+				return true
+			}
+		}
+		return false
+	}
+	return tryFn(0)
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMissions(t *testing.T) {
+	deck := NewMissionDeck()
+	require.Len(t, deck, 50)
+
+	// every mission is identified by its own id
+	require.Len(t, missionsById, len(deck))
+	for _, mission := range deck {
+		found, err := MissionFromId(MissionId(mission))
+		require.NoError(t, err, MissionId(mission))
+		require.Same(t, mission, found, MissionId(mission))
+	}
+
+	// missions built from a template match the embedded ones
+	for _, mission := range []Mission{
+		NewTwoColorsNextToEachOtherMission(CardColor_Yellow),
+		NewColorDoubleOfColorMission(CardColor_Green, CardColor_Blue),
+		NewColorSumMission(11, CardColor_Yellow),
+		NewSumMission(20),
+		NewAllTwoColorsMission(CardColor_Red, CardColor_Blue),
+		NewThreeOrderedValuesMission(),
+	} {
+		embedded, err := MissionFromId(MissionId(mission))
+		require.NoError(t, err, MissionId(mission))
+		require.Equal(t, mission.(*defMission).def, embedded.(*defMission).def, MissionId(mission))
+	}
+}
+
+func TestNewMission(t *testing.T) {
+
+	type TestCase struct {
+		tpl     string
+		args    map[string]any
+		wantErr error
+		wantId  string
+	}
+
+	testCases := map[string]TestCase{
+		"no-args":          {tpl: "mission-all-big", wantId: "mission-all-big"},
+		"color-args":       {tpl: "mission-color-double-of-color", args: map[string]any{"color1": "blue", "color2": "yellow"}, wantId: "mission-color-double-of-color:color1=blue:color2=yellow"},
+		"value-args":       {tpl: "mission-color-sum", args: map[string]any{"sum": 5, "color": "blue"}, wantId: "mission-color-sum:color=blue:sum=5"},
+		"unknown-template": {tpl: "mission-unknown", wantErr: ErrInvalidMission},
+		"missing-arg":      {tpl: "mission-sum", wantErr: ErrInvalidMission},
+		"invalid-arg":      {tpl: "mission-three-colors", args: map[string]any{"color": "purple"}, wantErr: ErrInvalidMission},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mission, err := NewMission(tc.tpl, tc.args)
+			require.ErrorIs(t, err, tc.wantErr)
+			if err == nil {
+				require.Equal(t, tc.wantId, MissionId(mission))
+			}
+		})
+	}
+}
+
+func TestParseMissionDefs(t *testing.T) {
+
+	type TestCase struct {
+		yaml    string
+		wantErr error
+		wantIds []string
+	}
+
+	testCases := map[string]TestCase{
+		"valid": {
+			yaml: `
+missions:
+- tpl: mission-sum
+  args: { sum: 12 }
+  sum: { equals: 12 }
+- tpl: mission-red-first
+  patterns: [ "R|B !E * *" ]
+`,
+			wantIds: []string{"mission-sum:sum=12", "mission-red-first"},
+		},
+		"template": {
+			yaml: `
+templates:
+  mission-first-color: |
+    patterns: [ "{color} * * *" ]
+missions:
+- tpl: mission-first-color
+  args: { color: red }
+- tpl: mission-first-color
+  args: { color: blue }
+`,
+			wantIds: []string{"mission-first-color:color=red", "mission-first-color:color=blue"},
+		},
+		"unknown-template": {
+			yaml:    "missions:\n- tpl: mission-first-color\n  args: { color: red }\n",
+			wantErr: ErrInvalidMission,
+		},
+		"missing-template-arg": {
+			yaml:    "templates:\n  mission-first-color: |\n    patterns: [ \"{color} * * *\" ]\nmissions:\n- tpl: mission-first-color\n",
+			wantErr: ErrInvalidMission,
+		},
+		"unknown-field": {
+			yaml:    "missions:\n- tpl: mission-sum\n  total: 12\n",
+			wantErr: ErrInvalidMission,
+		},
+		"missing-tpl": {
+			yaml:    "missions:\n- sum: { equals: 12 }\n",
+			wantErr: ErrInvalidMission,
+		},
+		"missing-rule": {
+			yaml:    "missions:\n- tpl: mission-sum\n",
+			wantErr: ErrInvalidMission,
+		},
+		"invalid-token": {
+			yaml:    "missions:\n- tpl: mission-x\n  patterns: [ \"R X * *\" ]\n",
+			wantErr: ErrInvalidMission,
+		},
+		"missing-token": {
+			yaml:    "missions:\n- tpl: mission-x\n  patterns: [ \"R * *\" ]\n",
+			wantErr: ErrInvalidMission,
+		},
+		"invalid-run": {
+			yaml:    "missions:\n- tpl: mission-x\n  run: { length: 5 }\n",
+			wantErr: ErrInvalidMission,
+		},
+		"duplicate": {
+			yaml:    "missions:\n- tpl: mission-x\n  patterns: [ \"R * * *\" ]\n- tpl: mission-x\n  patterns: [ \"B * * *\" ]\n",
+			wantErr: ErrDuplicateMission,
+		},
+		"impossible-sum": {
+			yaml:    "missions:\n- tpl: mission-sum\n  args: { sum: 29 }\n  sum: { equals: 29 }\n",
+			wantErr: ErrImpossibleMission,
+		},
+		"impossible-rules": {
+			yaml:    "missions:\n- tpl: mission-x\n  patterns: [ \"E E E E\" ]\n  distinct: [ value ]\n",
+			wantErr: ErrImpossibleMission,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defs, err := ParseMissionDefs([]byte(tc.yaml))
+			if err == nil {
+				var deck MissionDeck
+				deck, err = NewMissionDeckFromDefs(defs)
+				if err == nil {
+					require.Equal(t, tc.wantIds, deck.Ids())
+				}
+			}
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
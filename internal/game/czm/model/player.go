@@ -6,12 +6,12 @@ import (
 
 func NewPlayerFromUser(gameId share_model.GameId, user share_model.User) *Player {
 	return &Player{
-		Player: share_model.NewPlayerFromUser(gameId, user),
+		BasePlayer: share_model.NewPlayerFromUser(gameId, user),
 	}
 }
 
 type Player struct {
-	share_model.Player
+	*share_model.BasePlayer
 	Cards []Card
 }
 
@@ -22,9 +22,9 @@ func (p *Player) WithCard(card Card) *Player {
 
 func (p *Player) SelectCard(cardNumber int) (Card, error) {
 	if cardNumber < 1 || cardNumber > len(p.Cards) {
-		return p.Cards[cardNumber-1], nil
+		return 0, ErrInvalidCardNumber
 	}
-	return 0, ErrInvalidCardIndex
+	return p.Cards[cardNumber-1], nil
 }
 
 func (p *Player) PlayCard(cardNumber int) (Card, error) {
@@ -32,6 +32,6 @@ func (p *Player) PlayCard(cardNumber int) (Card, error) {
 	if err != nil {
 		return 0, err
 	}
-	p.Cards = append(p.Cards[:cardNumber-1], p.Cards[cardNumber-1:]...)
+	p.Cards = append(p.Cards[:cardNumber-1], p.Cards[cardNumber:]...)
 	return card, nil
 }
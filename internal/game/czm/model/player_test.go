@@ -0,0 +1,57 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestPlayerCards(t *testing.T) {
+
+	type TestCase struct {
+		cards      string
+		cardNumber int
+		wantErr    error
+		wantCard   string
+		wantCards  string
+	}
+
+	testCases := map[string]TestCase{
+		"zero":   {cards: "R1 G2 B3", cardNumber: 0, wantErr: ErrInvalidCardNumber, wantCards: "R1 G2 B3"},
+		"first":  {cards: "R1 G2 B3", cardNumber: 1, wantCard: "R1", wantCards: "G2 B3"},
+		"middle": {cards: "R1 G2 B3", cardNumber: 2, wantCard: "G2", wantCards: "R1 B3"},
+		"last":   {cards: "R1 G2 B3", cardNumber: 3, wantCard: "B3", wantCards: "R1 G2"},
+		"after":  {cards: "R1 G2 B3", cardNumber: 4, wantErr: ErrInvalidCardNumber, wantCards: "R1 G2 B3"},
+		"empty":  {cards: "", cardNumber: 1, wantErr: ErrInvalidCardNumber, wantCards: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			player := NewPlayerFromUser("G1", share_model.NewUser("U1"))
+			for _, card := range cardsFromString(tc.cards) {
+				player.WithCard(card)
+			}
+
+			selected, err := player.SelectCard(tc.cardNumber)
+			require.ErrorIs(t, err, tc.wantErr)
+			played, err := player.PlayCard(tc.cardNumber)
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr == nil {
+				require.Equal(t, CardFromString(tc.wantCard), selected)
+				require.Equal(t, selected, played)
+			}
+			require.Equal(t, cardsFromString(tc.wantCards), player.Cards)
+		})
+	}
+}
+
+func cardsFromString(value string) []Card {
+	var cards []Card
+	for _, card := range strings.Fields(value) {
+		cards = append(cards, CardFromString(card))
+	}
+	return cards
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// game snapshot
+
+type GameSnapshot struct {
+	Game                  share_model.GameSnapshot `json:"game"`
+	Players               []PlayerSnapshot         `json:"players"`
+	Difficulty            Difficulty               `json:"difficulty,omitempty"`
+	DrawCardDeck          CardDeck                 `json:"draw-card-deck"`
+	DiscardCardDecks      [NbCardDeck]CardDeck     `json:"discard-card-decks"`
+	SelectedCardNumber    int                      `json:"selected-card-number"`
+	DrawMissionDeck       []string                 `json:"draw-mission-deck"`
+	Missions              [NbMission]string        `json:"missions"`
+	DiscardMissionDeck    []string                 `json:"discard-mission-deck"`
+	ValidatedMissionIndex int                      `json:"validated-mission-index"`
+	Medal                 Medal                    `json:"medal"`
+}
+
+func NewGameSnapshot(game *Game) *GameSnapshot {
+	players := game.Players()
+	snapshot := &GameSnapshot{
+		Game:                  game.Snapshot(),
+		Players:               make([]PlayerSnapshot, 0, len(players)),
+		Difficulty:            game.Difficulty,
+		DrawCardDeck:          game.DrawCardDeck,
+		DiscardCardDecks:      game.DiscardCardDecks,
+		SelectedCardNumber:    game.SelectedCardNumber,
+		DrawMissionDeck:       game.DrawMissionDeck.Ids(),
+		DiscardMissionDeck:    game.DiscardMissionDeck.Ids(),
+		ValidatedMissionIndex: game.ValidatedMissionIndex,
+		Medal:                 game.Medal,
+	}
+	for _, player := range players {
+		snapshot.Players = append(snapshot.Players, NewPlayerSnapshot(player))
+	}
+	for index, mission := range game.Missions {
+		snapshot.Missions[index] = MissionId(mission)
+	}
+	return snapshot
+}
+
+func (s *GameSnapshot) ToGame() (*Game, error) {
+	players := make([]*Player, 0, len(s.Players))
+	for _, player := range s.Players {
+		players = append(players, player.ToPlayer())
+	}
+	drawMissionDeck, err := NewMissionDeckFromIds(s.DrawMissionDeck)
+	if err != nil {
+		return nil, err
+	}
+	discardMissionDeck, err := NewMissionDeckFromIds(s.DiscardMissionDeck)
+	if err != nil {
+		return nil, err
+	}
+	game := &Game{
+		BaseGame:              share_model.NewGameFromSnapshot(s.Game, players),
+		Difficulty:            DifficultyFromString(string(s.Difficulty)),
+		DrawCardDeck:          s.DrawCardDeck,
+		DiscardCardDecks:      s.DiscardCardDecks,
+		SelectedCardNumber:    s.SelectedCardNumber,
+		DrawMissionDeck:       drawMissionDeck,
+		DiscardMissionDeck:    discardMissionDeck,
+		ValidatedMissionIndex: s.ValidatedMissionIndex,
+		Medal:                 s.Medal,
+	}
+	for index, id := range s.Missions {
+		game.Missions[index], err = MissionFromId(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return game, nil
+}
+
+// //////////////////////////////////////////////////
+// player snapshot
+
+type PlayerSnapshot struct {
+	Player share_model.PlayerSnapshot `json:"player"`
+	Cards  []Card                     `json:"cards"`
+}
+
+func NewPlayerSnapshot(player *Player) PlayerSnapshot {
+	return PlayerSnapshot{
+		Player: player.Snapshot(),
+		Cards:  player.Cards,
+	}
+}
+
+func (s PlayerSnapshot) ToPlayer() *Player {
+	return &Player{
+		BasePlayer: share_model.NewPlayerFromSnapshot(s.Player),
+		Cards:      s.Cards,
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	share_service "github.com/gre-ory/games-go/internal/game/share/service"
+
+	"github.com/gre-ory/games-go/internal/game/czm/model"
+)
+
+// //////////////////////////////////////////////
+// bot
+
+const (
+	// completedMissionScore outweighs any number of missions made completable.
+	completedMissionScore = 1_000_000
+)
+
+type botAction = share_service.BotAction[*model.Player, *model.Game]
+
+// NewBot returns a mission-aware bot: it completes a visible mission whenever its hand allows,
+// and otherwise leaves the top cards as close as possible to completing missions on the next plays.
+func NewBot() share_service.Bot[*model.Player, *model.Game] {
+	return &bot{
+		cards: allCards(),
+	}
+}
+
+type bot struct {
+	// cards are the distinct cards of the game.
+	cards []model.Card
+}
+
+func (b *bot) NextAction(game *model.Game, player *model.Player) (botAction, error) {
+	if len(player.Cards) == 0 {
+		return botAction{}, model.ErrInvalidCardNumber
+	}
+
+	//
+	// pick a card, then the pile to play it on
+	//
+
+	if game.SelectedCardNumber == 0 {
+		cardNumber, _ := b.bestPlay(game, player, 0)
+		return botAction{
+			EventType: model.EventType_SelectCard,
+			Payload:   model.SelectCardPayload{CardNumber: cardNumber},
+			PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+				return false, selectCard(game, player, cardNumber)
+			},
+		}, nil
+	}
+
+	_, discardNumber := b.bestPlay(game, player, game.SelectedCardNumber)
+	return botAction{
+		EventType: model.EventType_PlayCard,
+		Payload:   model.PlayCardPayload{DiscardNumber: discardNumber},
+		PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+			return playCard(game, player, discardNumber)
+		},
+	}, nil
+}
+
+// bestPlay returns the card number and discard number of the best play, restricted to the given card number if any.
+func (b *bot) bestPlay(game *model.Game, player *model.Player, onlyCardNumber int) (int, int) {
+	topCards := game.GetTopCards()
+	bestCardNumber, bestDiscardNumber, bestScore := 0, 0, -1
+	for index, card := range player.Cards {
+		cardNumber := index + 1
+		if onlyCardNumber != 0 && cardNumber != onlyCardNumber {
+			continue
+		}
+		for discardIndex := range topCards {
+			played := topCards
+			played[discardIndex] = card
+			if score := b.score(game, played); score > bestScore {
+				bestCardNumber, bestDiscardNumber, bestScore = cardNumber, discardIndex+1, score
+			}
+		}
+	}
+	return bestCardNumber, bestDiscardNumber
+}
+
+// score favours completing a mission, then the number of single plays completing a mission from the given top cards.
+func (b *bot) score(game *model.Game, topCards model.TopCards) int {
+	score := 0
+	for _, mission := range game.Missions {
+		if mission == nil {
+			continue
+		}
+		if mission.IsCompleted(topCards) {
+			score += completedMissionScore
+			continue
+		}
+		for discardIndex := range topCards {
+			next := topCards
+			for _, card := range b.cards {
+				next[discardIndex] = card
+				if mission.IsCompleted(next) {
+					score++
+				}
+			}
+		}
+	}
+	return score
+}
+
+func allCards() []model.Card {
+	cards := make([]model.Card, 0)
+	for _, color := range model.CardColors {
+		for value := model.Card_MinValue; value <= model.Card_MaxValue; value++ {
+			cards = append(cards, model.NewCard(value, color))
+		}
+	}
+	return cards
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+	share_store "github.com/gre-ory/games-go/internal/game/share/store"
+
+	"github.com/gre-ory/games-go/internal/game/czm/model"
+	"github.com/gre-ory/games-go/internal/game/czm/store"
+)
+
+func TestBotCompletesMission(t *testing.T) {
+	game := newTestGame(t)
+	player := game.RoundPlayer()
+	player.Cards = []model.Card{
+		model.NewCard(2, model.CardColor_Red),
+		model.NewCard(3, model.CardColor_Blue),
+		model.NewCard(6, model.CardColor_Green),
+		model.NewCard(1, model.CardColor_Yellow),
+		model.NewCard(4, model.CardColor_Red),
+	}
+	for index, value := range []model.CardValue{5, 7, 1, 6} {
+		game.DiscardCardDecks[index].Add(model.NewCard(value, model.CardColor_Blue))
+	}
+
+	// all big: the only big card goes on the only small top card
+	bot := NewBot()
+	action, err := bot.NextAction(game, player)
+	require.NoError(t, err)
+	require.Equal(t, model.EventType_SelectCard, action.EventType)
+	require.Equal(t, model.SelectCardPayload{CardNumber: 3}, action.Payload)
+	_, err = action.PlayFn(game, player)
+	require.NoError(t, err)
+
+	action, err = bot.NextAction(game, player)
+	require.NoError(t, err)
+	require.Equal(t, model.EventType_PlayCard, action.EventType)
+	require.Equal(t, model.PlayCardPayload{DiscardNumber: 3}, action.Payload)
+	_, err = action.PlayFn(game, player)
+	require.NoError(t, err)
+	require.True(t, game.HasValidatedMission())
+}
+
+func TestBotGame(t *testing.T) {
+
+	service := NewGameService(zap.NewNop(), store.NewGameStore())
+	service.SetBotDelay(-1)
+	eventStore := share_store.NewEventMemoryStore()
+	service.RegisterOnEvent(func(event share_model.Event) {
+		require.NoError(t, eventStore.Append(event))
+	})
+
+	user := share_model.NewUser("U1")
+	game, err := service.CreateGame(user, share_model.GameOptions{})
+	require.NoError(t, err)
+	human := game.MustPlayer(share_model.NewPlayerId(game.Id(), user.Id()))
+	for nbBot := 0; nbBot < 3; nbBot++ {
+		_, err = service.AddBot(human)
+		require.NoError(t, err)
+	}
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+
+	// the human plays as a bot would
+	humanBot := NewBot()
+	for !game.IsStopped() {
+		if human.IsPlaying() {
+			action, err := humanBot.NextAction(game, human)
+			require.NoError(t, err)
+			_, err = service.Play(human, game.Version(), action.EventType, action.Payload, action.PlayFn)
+			require.NoError(t, err)
+		} else {
+			_, err = service.PlayBot(game.Id())
+			require.NoError(t, err)
+		}
+	}
+	require.NotEmpty(t, game.DiscardMissionDeck)
+
+	events, err := eventStore.List(game.Id())
+	require.NoError(t, err)
+	replayed, err := service.ReplayGame(events)
+	require.NoError(t, err)
+	require.Equal(t, game.DiscardMissionDeck.Ids(), replayed.DiscardMissionDeck.Ids())
+	require.Equal(t, game.Medal, replayed.Medal)
+}
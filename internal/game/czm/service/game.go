@@ -1,7 +1,6 @@
 package service
 
 import (
-	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	share_model "github.com/gre-ory/games-go/internal/game/share/model"
@@ -14,16 +13,20 @@ import (
 type GameService interface {
 	share_service.GameService[*model.Player, *model.Game]
 
-	SelectCard(player *model.Player, cardNumber int) (*model.Game, error)
-	PlayCard(player *model.Player, discardNumber int) (*model.Game, error)
+	SelectCard(player *model.Player, version, cardNumber int) (*model.Game, error)
+	PlayCard(player *model.Player, version, discardNumber int) (*model.Game, error)
+
+	ReplayGame(events []share_model.Event) (*model.Game, error)
 }
 
 func NewGameService(logger *zap.Logger, gameStore store.GameStore) GameService {
 	plugin := NewGamePlugin()
-	return &gameService{
+	service := &gameService{
 		GameService: share_service.NewGameService(logger, plugin, gameStore),
 		logger:      logger,
 	}
+	service.SetBot(NewBot())
+	return service
 }
 
 type gameService struct {
@@ -31,103 +34,105 @@ type gameService struct {
 	logger *zap.Logger
 }
 
-func (s *gameService) SelectCard(player *model.Player, cardNumber int) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
-	if err != nil {
-		return nil, err
-	}
+func (s *gameService) SelectCard(player *model.Player, version, cardNumber int) (*model.Game, error) {
+	payload := model.SelectCardPayload{CardNumber: cardNumber}
+	return s.Play(player, version, model.EventType_SelectCard, payload, func(game *model.Game, player *model.Player) (bool, error) {
+		return false, selectCard(game, player, cardNumber)
+	})
+}
 
-	_, err = player.SelectCard(cardNumber)
+func selectCard(game *model.Game, player *model.Player, cardNumber int) error {
+	_, err := player.SelectCard(cardNumber)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	game.SelectedCardNumber = cardNumber
 
-	return game, nil
+	return nil
 }
 
-func (s *gameService) PlayCard(player *model.Player, discardNumber int) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
-	if err != nil {
-		return nil, err
-	}
+func (s *gameService) PlayCard(player *model.Player, version, discardNumber int) (*model.Game, error) {
+	payload := model.PlayCardPayload{DiscardNumber: discardNumber}
+	return s.Play(player, version, model.EventType_PlayCard, payload, func(game *model.Game, player *model.Player) (bool, error) {
+		return playCard(game, player, discardNumber)
+	})
+}
+
+func playCard(game *model.Game, player *model.Player, discardNumber int) (bool, error) {
 	if game.SelectedCardNumber == 0 {
-		return nil, model.ErrNoSelectedCard
+		return false, model.ErrNoSelectedCard
+	}
+	if discardNumber < 1 || model.NbCardDeck < discardNumber {
+		return false, model.ErrInvalidDiscardNumber
 	}
 
+	// nothing can fail once the card is played
 	selectedCard, err := player.PlayCard(game.SelectedCardNumber)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	if discardNumber < 1 || model.NbCardDeck < discardNumber {
-		return nil, model.ErrInvalidDiscardNumber
+	game.SelectedCardNumber = 0
+	game.DiscardCardDecks[discardNumber-1].Add(selectedCard)
+
+	//
+	// check missions against the new top cards
+	//
+
+	game.ValidateMissions()
+	if !game.HasMissionsLeft() {
+		game.SetCompleted()
+		return true, nil
 	}
 
-	game.SelectedCardNumber = 0
-	game.DiscardCardDecks[discardNumber].Add(selectedCard)
+	//
+	// draw a new card
+	//
 
 	newCard, err := game.DrawCardDeck.Draw()
 	if err != nil {
-		return nil, err
+		// the draw deck is exhausted
+		game.SetExhausted()
+		return true, nil
 	}
 	player.WithCard(newCard)
 
-	// TODO
-	// if yes, winnerId := game.HasWinner(); yes {
-	// 	s.stopGame(game, winnerId)
-	// } else if game.IsTie() {
-	// 	s.stopGame(game, "")
-	// } else {
-	// 	game.Round++
-	// 	game.SetPlayingPlayer()
-	// }
-
-	return game, nil
+	game.NextRound()
+	game.SetPlayingRoundPlayer()
+	return false, nil
 }
 
-func (s *gameService) ValidateMissions(player *model.Player, cardIndex int, discardIndex int) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
-	if err != nil {
-		return nil, err
-	}
+// //////////////////////////////////////////////
+// replay
 
-	topCards := game.GetTopCards()
+// ReplayGame rebuilds a game from its recorded events in a separate in-memory service.
+func (s *gameService) ReplayGame(events []share_model.Event) (*model.Game, error) {
+	return share_service.Replay(s.logger, NewGamePlugin(), events, replayEvent)
+}
 
-	game.ValidatedMissionIndex = -1
-	for index, mission := range game.Missions {
-		if mission == nil {
-			continue
+// replayEvent turns a recorded event into the action it played.
+func replayEvent(event share_model.Event) (func(game *model.Game, player *model.Player) (bool, error), error) {
+	switch event.Type {
+	case model.EventType_SelectCard:
+		var payload model.SelectCardPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return nil, err
 		}
-		if mission.IsCompleted(topCards) {
-			game.ValidatedMissionIndex = index
-			game.DiscardMissionDeck.Add(mission)
-			// draw new mission
-			newMission, err := game.DrawMissionDeck.Draw()
-			if errors.Is(err, model.ErrEmptyMissionDeck) {
-				// TODO win
-				return nil, err
-			}
-			game.Missions[index] = newMission
-			break
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return false, selectCard(game, player, payload.CardNumber)
+		}, nil
+	case model.EventType_PlayCard:
+		var payload model.PlayCardPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return nil, err
 		}
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return playCard(game, player, payload.DiscardNumber)
+		}, nil
+	default:
+		return nil, share_model.ErrUnknownEvent
 	}
-	return game, nil
-}
-
-func (s *gameService) getPlayGame(player *model.Player) (*model.Game, error) {
-	game, err := s.GetGame(player.GameId())
-	if err != nil {
-		return nil, err
-	}
-	if err := game.Status().CanPlay(); err != nil {
-		return nil, err
-	}
-	if !player.Status().IsPlaying() {
-		return nil, share_model.ErrWrongPlayer
-	}
-	return game, nil
 }
 
 // //////////////////////////////////////////////
@@ -143,8 +148,11 @@ func (p *gamePlugin) CanCreateGame(user share_model.User) error {
 	return nil
 }
 
-func (p *gamePlugin) CreateGame(user share_model.User) (*model.Game, *model.Player, error) {
-	game := model.NewGame()
+func (p *gamePlugin) CreateGame(user share_model.User, setup share_model.GameSetup) (*model.Game, *model.Player, error) {
+	game, err := model.NewGameFromSetup(setup)
+	if err != nil {
+		return nil, nil, err
+	}
 	player := model.NewPlayerFromUser(game.Id(), user)
 	return game, player, nil
 }
@@ -168,10 +176,26 @@ func (p *gamePlugin) CanStartGame(game *model.Game) error {
 func (p *gamePlugin) StartGame(game *model.Game) (*model.Game, error) {
 
 	//
-	// set random order
+	// reveal the first missions
 	//
 
-	game.SetRandomOrder()
+	game.DrawMissions()
+
+	//
+	// set order ( random, or rotated from the previous game of the table )
+	//
+
+	if !game.SetRematchOrder() {
+		game.SetRandomOrder()
+	}
+
+	//
+	// deal hands
+	//
+
+	if err := game.DealHands(); err != nil {
+		return nil, err
+	}
 
 	//
 	// set first playing player
@@ -216,3 +240,13 @@ func (p *gamePlugin) LeaveGame(game *model.Game, player *model.Player) (*model.G
 func (p *gamePlugin) CanDeleteGame(game *model.Game, playerId share_model.PlayerId) error {
 	return nil
 }
+
+// PlayDefault ends the turn of the player by playing the selected card, or the first card of the hand, on the first discard pile.
+func (p *gamePlugin) PlayDefault(game *model.Game, player *model.Player) (bool, error) {
+	if game.SelectedCardNumber == 0 {
+		if err := selectCard(game, player, 1); err != nil {
+			return false, err
+		}
+	}
+	return playCard(game, player, 1)
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+
+	"github.com/gre-ory/games-go/internal/game/czm/model"
+)
+
+func newTestGame(t *testing.T) *model.Game {
+	game := model.NewGame(share_model.GameSetup{Id: "G1", Seed: 1}, model.Difficulty_Normal)
+	game.AttachPlayer(model.NewPlayerFromUser(game.Id(), share_model.NewUser("U1")))
+	game.AttachPlayer(model.NewPlayerFromUser(game.Id(), share_model.NewUser("U2")))
+	game.SetRandomOrder()
+	require.NoError(t, game.DealHands())
+	game.FirstRound()
+	game.SetPlayingRoundPlayer()
+	for index := range game.Missions {
+		game.Missions[index] = model.NewAllBigMission()
+	}
+	return game
+}
+
+func TestPlayCard(t *testing.T) {
+
+	type TestCase struct {
+		cardNumber    int
+		discardNumber int
+		emptyDeck     bool
+		wantErr       error
+		wantStop      bool
+		wantNextTurn  bool
+	}
+
+	testCases := map[string]TestCase{
+		"no-selected-card":  {cardNumber: 0, discardNumber: 1, wantErr: model.ErrNoSelectedCard},
+		"discard-too-low":   {cardNumber: 1, discardNumber: 0, wantErr: model.ErrInvalidDiscardNumber},
+		"discard-too-high":  {cardNumber: 1, discardNumber: 5, wantErr: model.ErrInvalidDiscardNumber},
+		"first-discard":     {cardNumber: 1, discardNumber: 1, wantNextTurn: true},
+		"last-discard":      {cardNumber: 5, discardNumber: 4, wantNextTurn: true},
+		"empty-draw-deck":   {cardNumber: 2, discardNumber: 2, emptyDeck: true, wantStop: true},
+		"invalid-selection": {cardNumber: 6, discardNumber: 1, wantErr: model.ErrInvalidCardNumber},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newTestGame(t)
+			player := game.RoundPlayer()
+			round := game.Round()
+			hand := append([]model.Card{}, player.Cards...)
+			if tc.emptyDeck {
+				game.DrawCardDeck = model.CardDeck{}
+			}
+
+			if tc.cardNumber != 0 {
+				err := selectCard(game, player, tc.cardNumber)
+				if err != nil {
+					require.ErrorIs(t, err, tc.wantErr)
+					return
+				}
+			}
+
+			stop, err := playCard(game, player, tc.discardNumber)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantStop, stop)
+			if err != nil {
+				// a rejected card leaves the game untouched
+				require.Equal(t, hand, player.Cards)
+				for _, discardDeck := range game.DiscardCardDecks {
+					require.True(t, discardDeck.IsEmpty())
+				}
+				require.Equal(t, round, game.Round())
+				return
+			}
+
+			require.Equal(t, hand[tc.cardNumber-1], game.DiscardCardDecks[tc.discardNumber-1].GetTopCard())
+			remaining := append(append([]model.Card{}, hand[:tc.cardNumber-1]...), hand[tc.cardNumber:]...)
+			require.Equal(t, remaining, player.Cards[:len(remaining)])
+			require.Equal(t, 0, game.SelectedCardNumber)
+			if tc.wantNextTurn {
+				require.Len(t, player.Cards, len(hand))
+				require.Equal(t, round+1, game.Round())
+				require.False(t, player.IsPlaying())
+				require.True(t, game.RoundPlayer().IsPlaying())
+			}
+		})
+	}
+}
+
+func TestEndOfGame(t *testing.T) {
+
+	type TestCase struct {
+		nbCompleted   int
+		drawMissions  int
+		otherMissions bool
+		emptyDeck     bool
+		completeFirst bool
+		wantStop      bool
+		wantMedal     model.Medal
+		wantWin       bool
+	}
+
+	testCases := map[string]TestCase{
+		"missions-left-in-deck":   {nbCompleted: 10, drawMissions: 1, completeFirst: true},
+		"visible-missions-left":   {nbCompleted: 10, otherMissions: true, completeFirst: true},
+		"all-missions-completed":  {nbCompleted: 49, completeFirst: true, wantStop: true, wantMedal: model.Medal_Gold, wantWin: true},
+		"exhausted-without-medal": {nbCompleted: 15, drawMissions: 1, emptyDeck: true, wantStop: true, wantMedal: model.Medal_None},
+		"exhausted-with-bronze":   {nbCompleted: 16, drawMissions: 1, emptyDeck: true, wantStop: true, wantMedal: model.Medal_Bronze, wantWin: true},
+		"exhausted-with-silver":   {nbCompleted: 20, drawMissions: 1, emptyDeck: true, wantStop: true, wantMedal: model.Medal_Silver, wantWin: true},
+		"exhausted-with-gold":     {nbCompleted: 24, drawMissions: 1, emptyDeck: true, wantStop: true, wantMedal: model.Medal_Gold, wantWin: true},
+		"completed-on-last-card":  {nbCompleted: 23, drawMissions: 1, emptyDeck: true, completeFirst: true, wantStop: true, wantMedal: model.Medal_Gold, wantWin: true},
+		"completed-before-silver": {nbCompleted: 18, drawMissions: 1, emptyDeck: true, completeFirst: true, wantStop: true, wantMedal: model.Medal_Bronze, wantWin: true},
+		"exhausted-before-bronze": {nbCompleted: 14, otherMissions: true, emptyDeck: true, completeFirst: true, wantStop: true, wantMedal: model.Medal_None},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newTestGame(t)
+			player := game.RoundPlayer()
+			allMissions := model.NewMissionDeck()
+			game.DiscardMissionDeck = append(model.MissionDeck{}, allMissions[:tc.nbCompleted]...)
+			game.DrawMissionDeck = append(model.MissionDeck{}, allMissions[tc.nbCompleted:tc.nbCompleted+tc.drawMissions]...)
+			if !tc.otherMissions {
+				game.Missions = [model.NbMission]model.Mission{game.Missions[0]}
+			}
+			if tc.emptyDeck {
+				game.DrawCardDeck = model.CardDeck{}
+			}
+			if tc.completeFirst {
+				// the played card completes the first mission
+				game.Missions[0] = model.NewSumMission(10)
+				topCards := model.TopCardsFromString("R1 G2 B3 Y4")
+				player.Cards[0] = topCards[0]
+				for index := 1; index < model.NbCardDeck; index++ {
+					game.DiscardCardDecks[index].Add(topCards[index])
+				}
+			}
+
+			require.NoError(t, selectCard(game, player, 1))
+			stop, err := playCard(game, player, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStop, stop)
+			if tc.completeFirst {
+				require.Equal(t, 0, game.GetValidatedMissionIndex())
+				require.Equal(t, tc.nbCompleted+1, game.DiscardMissionDeck.Size())
+			}
+			if !tc.wantStop {
+				require.True(t, game.HasMissionsLeft())
+				require.False(t, player.HasResult())
+				return
+			}
+
+			require.Equal(t, tc.wantMedal, game.Medal)
+			for _, player := range game.Players() {
+				require.Equal(t, tc.wantWin, player.Result().IsWin())
+				require.Equal(t, !tc.wantWin, player.Result().IsLoose())
+			}
+		})
+	}
+}
+
+func TestPlayDefault(t *testing.T) {
+
+	type TestCase struct {
+		cardNumber int
+	}
+
+	testCases := map[string]TestCase{
+		"no-selected-card": {cardNumber: 0},
+		"selected-card":    {cardNumber: 3},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newTestGame(t)
+			player := game.RoundPlayer()
+			round := game.Round()
+			hand := append([]model.Card{}, player.Cards...)
+			if tc.cardNumber != 0 {
+				require.NoError(t, selectCard(game, player, tc.cardNumber))
+			}
+
+			// the default move plays the selected card, or the first one, on the first discard pile
+			stop, err := NewGamePlugin().PlayDefault(game, player)
+			require.NoError(t, err)
+			require.False(t, stop)
+			wantCardNumber := max(tc.cardNumber, 1)
+			require.Equal(t, hand[wantCardNumber-1], game.DiscardCardDecks[0].GetTopCard())
+			require.Equal(t, 0, game.SelectedCardNumber)
+			require.Equal(t, round+1, game.Round())
+			require.NotEqual(t, player.Id(), game.RoundPlayer().Id())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	"github.com/gre-ory/games-go/internal/game/czm/model"
+)
+
+// //////////////////////////////////////////////
+// simulation
+
+// NewSimulationConfig simulates games between bots, counting the medals earned.
+func NewSimulationConfig(nbPlayer int) simulation.Config[*model.Player, *model.Game] {
+	return simulation.Config[*model.Player, *model.Game]{
+		NewPlayers: simulation.NewPlayers(nbPlayer, NewBot),
+		Invariants: SimulationInvariants(),
+		Outcome: func(game *model.Game) string {
+			if game.Medal.IsNone() {
+				return "no-medal"
+			}
+			return string(game.Medal)
+		},
+	}
+}
+
+// SimulationInvariants are the rules a simulated game must keep after each action.
+func SimulationInvariants() []simulation.Invariant[*model.Player, *model.Game] {
+	return []simulation.Invariant[*model.Player, *model.Game]{
+		{
+			Name: "cards",
+			CheckFn: func(game *model.Game) error {
+				nbCard := make(map[model.Card]int)
+				for _, card := range game.DrawCardDeck {
+					nbCard[card]++
+				}
+				for _, discardDeck := range game.DiscardCardDecks {
+					for _, card := range discardDeck {
+						nbCard[card]++
+					}
+				}
+				for _, player := range game.Players() {
+					for _, card := range player.Cards {
+						nbCard[card]++
+					}
+				}
+				for _, card := range allCards() {
+					if nbCard[card] != model.Card_NbPerValue {
+						return fmt.Errorf("%d cards %s instead of %d", nbCard[card], card, model.Card_NbPerValue)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name: "hands",
+			CheckFn: func(game *model.Game) error {
+				nbCard := game.Difficulty.NbCard(game.NbPlayer())
+				for _, player := range game.Players() {
+					if len(player.Cards) > nbCard {
+						return fmt.Errorf("player %s holds %d cards instead of %d", player.Id(), len(player.Cards), nbCard)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name: "missions",
+			CheckFn: func(game *model.Game) error {
+				ids := make(map[string]bool)
+				missions := append(append(model.MissionDeck{}, game.DrawMissionDeck...), game.DiscardMissionDeck...)
+				for _, mission := range game.Missions {
+					if mission != nil {
+						missions.Add(mission)
+					}
+				}
+				for _, id := range missions.Ids() {
+					if ids[id] {
+						return fmt.Errorf("mission %s found twice", id)
+					}
+					ids[id] = true
+				}
+				nbMission := 0
+				for _, mission := range model.NewMissionDeck() {
+					if game.Difficulty.IncludesMission(mission) {
+						nbMission++
+					}
+				}
+				if len(ids) != nbMission {
+					return fmt.Errorf("%d missions instead of %d", len(ids), nbMission)
+				}
+				return nil
+			},
+		},
+	}
+}
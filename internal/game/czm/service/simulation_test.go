@@ -0,0 +1,47 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	"github.com/gre-ory/games-go/internal/game/czm/model"
+	"github.com/gre-ory/games-go/internal/game/czm/store"
+)
+
+func TestSimulation(t *testing.T) {
+
+	type TestCase struct {
+		nbPlayer   int
+		difficulty model.Difficulty
+	}
+
+	testCases := map[string]TestCase{
+		"two-players": {
+			nbPlayer: 2,
+		},
+		"four-players": {
+			nbPlayer: 4,
+		},
+	}
+	for _, difficulty := range model.Difficulties {
+		testCases[string(difficulty)] = TestCase{
+			nbPlayer:   3,
+			difficulty: difficulty,
+		}
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := NewSimulationConfig(tc.nbPlayer)
+			config.NbGame = 20
+			config.Settings = model.Settings{Difficulty: string(tc.difficulty)}
+			report := simulation.Run(NewGameService(zap.NewNop(), store.NewGameStore()), config)
+			require.Empty(t, report.Failures)
+			require.Equal(t, 20, report.NbFinished)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package store
+
+import (
+	"encoding/json"
+
+	share_store "github.com/gre-ory/games-go/internal/game/share/store"
+
+	"github.com/gre-ory/games-go/internal/game/czm/model"
+)
+
+func NewGameCodec() share_store.GameCodec[*model.Game] {
+	return &gameCodec{}
+}
+
+type gameCodec struct{}
+
+func (c *gameCodec) Encode(game *model.Game) ([]byte, error) {
+	return json.Marshal(model.NewGameSnapshot(game))
+}
+
+func (c *gameCodec) Decode(data []byte) (*model.Game, error) {
+	snapshot := &model.GameSnapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot.ToGame()
+}
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"go.uber.org/zap"
+
 	share_store "github.com/gre-ory/games-go/internal/game/share/store"
 
 	"github.com/gre-ory/games-go/internal/game/czm/model"
@@ -13,3 +15,7 @@ type GameStore interface {
 func NewGameStore() GameStore {
 	return share_store.NewGameMemoryStore[*model.Game]()
 }
+
+func NewGameFileStore(logger *zap.Logger, dir string) (GameStore, error) {
+	return share_store.NewGameFileStore(logger, dir, NewGameCodec())
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/util"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+type InviteServer interface {
+	HandleJoinInviteCode(code model.InviteCode, user model.User) error
+}
+
+// PageJoin joins the private game of the invite code and sends the user to the home page, where the game lobby shows up.
+func PageJoin(logger *zap.Logger, app model.App, cookieServer CookieServer, inviteServer InviteServer) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger.Info("[api] page_join", zap.String("path", r.URL.Path))
+
+		var cookie *model.Cookie
+		var err error
+
+		switch {
+		default:
+
+			//
+			// cookie
+			//
+
+			cookie = cookieServer.GetCookieOrDefault(r)
+
+			err = cookieServer.SetCookie(w, cookie)
+			if err != nil {
+				break
+			}
+
+			//
+			// join game
+			//
+
+			code := model.InviteCode(util.ExtractPathParameter(r.Context(), "code"))
+			err = inviteServer.HandleJoinInviteCode(code, model.NewUserFromCookie(cookie))
+			if err != nil {
+				break
+			}
+
+			//
+			// redirect
+			//
+
+			http.Redirect(w, r, app.HomeRoute(), http.StatusSeeOther)
+			return
+		}
+
+		// error response
+		util.EncodeJsonErrorResponse(w, err)
+	}
+}
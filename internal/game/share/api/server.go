@@ -12,17 +12,29 @@ import (
 // server
 
 type GameServer[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
-	HandleCreateGame(user model.User) error
+	HandleCreateGame(user model.User, options model.GameOptions, settings any) error
 	HandleJoinGame(gameId model.GameId, user model.User) error
+	HandleJoinInviteCode(code model.InviteCode, user model.User) error
+	HandleUpdateSettings(player PlayerT, options model.GameOptions) error
+	HandleKickPlayer(player PlayerT, playerId model.PlayerId) error
+	HandleSetReady(player PlayerT, ready bool) error
 	HandleStartGame(player PlayerT) error
+	HandleAddBot(player PlayerT) error
 	HandleLeaveGame(player PlayerT) error
+	HandleRematch(player PlayerT) error
 }
 
 type GameService[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
-	CreateGame(user model.User) (GameT, error)
+	CreateGameWithSettings(user model.User, options model.GameOptions, settings any) (GameT, error)
 	JoinGameId(gameId model.GameId, user model.User) (GameT, error)
+	JoinInviteCode(code model.InviteCode, user model.User) (GameT, error)
+	UpdatePlayerSettings(player PlayerT, options model.GameOptions) (GameT, error)
+	KickPlayer(host PlayerT, playerId model.PlayerId) (GameT, error)
+	SetPlayerReady(player PlayerT, ready bool) (GameT, error)
 	StartPlayerGame(player PlayerT) (GameT, error)
+	AddBot(player PlayerT) (GameT, error)
 	LeavePlayerGame(player PlayerT) (GameT, error)
+	RematchPlayerGame(player PlayerT) (GameT, error)
 }
 
 func NewGameServer[PlayerT model.Player, GameT model.Game[PlayerT]](logger *zap.Logger, service GameService[PlayerT, GameT]) GameServer[PlayerT, GameT] {
@@ -65,9 +77,9 @@ func (s *gameServer[PlayerT, GameT]) UnregisterPlayerId(playerId model.PlayerId)
 // //////////////////////////////////////////////////
 // create game
 
-func (s *gameServer[PlayerT, GameT]) HandleCreateGame(user model.User) error {
+func (s *gameServer[PlayerT, GameT]) HandleCreateGame(user model.User, options model.GameOptions, settings any) error {
 	s.logger.Info("[ws] create_game")
-	_, err := s.service.CreateGame(user)
+	_, err := s.service.CreateGameWithSettings(user, options, settings)
 	return err
 }
 
@@ -83,6 +95,45 @@ func (s *gameServer[PlayerT, GameT]) HandleJoinGame(gameId model.GameId, user mo
 	return err
 }
 
+func (s *gameServer[PlayerT, GameT]) HandleJoinInviteCode(code model.InviteCode, user model.User) error {
+	s.logger.Info("[api] join_invite_code")
+	if code == "" {
+		return model.ErrMissingInviteCode
+	}
+	_, err := s.service.JoinInviteCode(code, user)
+	return err
+}
+
+// //////////////////////////////////////////////////
+// update settings
+
+func (s *gameServer[PlayerT, GameT]) HandleUpdateSettings(player PlayerT, options model.GameOptions) error {
+	s.logger.Info("[ws] update_settings")
+	_, err := s.service.UpdatePlayerSettings(player, options)
+	return err
+}
+
+// //////////////////////////////////////////////////
+// kick player
+
+func (s *gameServer[PlayerT, GameT]) HandleKickPlayer(player PlayerT, playerId model.PlayerId) error {
+	s.logger.Info("[ws] kick_player")
+	if playerId == "" {
+		return model.ErrInvalidPlayerId
+	}
+	_, err := s.service.KickPlayer(player, playerId)
+	return err
+}
+
+// //////////////////////////////////////////////////
+// set ready
+
+func (s *gameServer[PlayerT, GameT]) HandleSetReady(player PlayerT, ready bool) error {
+	s.logger.Info("[ws] set_ready")
+	_, err := s.service.SetPlayerReady(player, ready)
+	return err
+}
+
 // //////////////////////////////////////////////////
 // start game
 
@@ -92,6 +143,15 @@ func (s *gameServer[PlayerT, GameT]) HandleStartGame(player PlayerT) error {
 	return err
 }
 
+// //////////////////////////////////////////////////
+// add bot
+
+func (s *gameServer[PlayerT, GameT]) HandleAddBot(player PlayerT) error {
+	s.logger.Info("[ws] add_bot")
+	_, err := s.service.AddBot(player)
+	return err
+}
+
 // //////////////////////////////////////////////////
 // leave game
 
@@ -100,3 +160,12 @@ func (s *gameServer[PlayerT, GameT]) HandleLeaveGame(player PlayerT) error {
 	_, err := s.service.LeavePlayerGame(player)
 	return err
 }
+
+// //////////////////////////////////////////////////
+// rematch
+
+func (s *gameServer[PlayerT, GameT]) HandleRematch(player PlayerT) error {
+	s.logger.Info("[ws] rematch")
+	_, err := s.service.RematchPlayerGame(player)
+	return err
+}
@@ -33,6 +33,8 @@ type App interface {
 	Route(path string) string
 	HomeRoute() string
 	HtmxConnectRoute() string
+	JoinRoute() string
+	InviteRoute(code InviteCode) string
 }
 
 func NewApp(id AppId) App {
@@ -80,3 +82,11 @@ func (a *app) HomeRoute() string {
 func (a *app) HtmxConnectRoute() string {
 	return a.Route("htmx/connect")
 }
+
+func (a *app) JoinRoute() string {
+	return a.Route("join/:code")
+}
+
+func (a *app) InviteRoute(code InviteCode) string {
+	return a.Route(fmt.Sprintf("join/%s", code))
+}
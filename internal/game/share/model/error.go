@@ -28,4 +28,23 @@ var (
 	ErrUnknownAction         = fmt.Errorf("unknown action")
 	ErrInvalidPlayerId       = fmt.Errorf("invalid player id")
 	ErrInactiveUser          = fmt.Errorf("inactive user")
+	ErrMissingStoreDir       = fmt.Errorf("missing store dir")
+	ErrInvalidGameStatus     = fmt.Errorf("invalid game status")
+	ErrStaleGame             = fmt.Errorf("stale game, the board has changed in the meantime")
+	ErrMissingEvents         = fmt.Errorf("missing events")
+	ErrMissingEventPayload   = fmt.Errorf("missing event payload")
+	ErrUnknownEvent          = fmt.Errorf("unknown event")
+	ErrNoDefaultAction       = fmt.Errorf("no default action")
+	ErrAlreadyInGame         = fmt.Errorf("already in a game")
+	ErrNotSpectating         = fmt.Errorf("not spectating")
+	ErrSpectatorAction       = fmt.Errorf("spectators cannot act on the game")
+	ErrPrivateGame           = fmt.Errorf("private game, an invite code is required")
+	ErrMissingInviteCode     = fmt.Errorf("missing invite code")
+	ErrInvalidInviteCode     = fmt.Errorf("invalid invite code")
+	ErrBotNotSupported       = fmt.Errorf("bots are not supported by this game")
+	ErrNoPlayingBot          = fmt.Errorf("no bot has to play")
+	ErrNotHost               = fmt.Errorf("only the host can do this")
+	ErrCannotKickHost        = fmt.Errorf("the host cannot be kicked")
+	ErrPlayersNotReady       = fmt.Errorf("some players are not ready")
+	ErrInvalidSettings       = fmt.Errorf("invalid game settings")
 )
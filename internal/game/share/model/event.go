@@ -0,0 +1,88 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// //////////////////////////////////////////////////
+// event type
+
+type EventType string
+
+const (
+	EventType_CreateGame     EventType = "create-game"
+	EventType_JoinGame       EventType = "join-game"
+	EventType_StartGame      EventType = "start-game"
+	EventType_LeaveGame      EventType = "leave-game"
+	EventType_StopGame       EventType = "stop-game"
+	EventType_TurnTimeout    EventType = "turn-timeout"
+	EventType_UpdateSettings EventType = "update-settings"
+	EventType_KickPlayer     EventType = "kick-player"
+	EventType_SetReady       EventType = "set-ready"
+)
+
+// //////////////////////////////////////////////////
+// event
+
+// Event records a state-changing call on a game.
+// The payload holds the arguments of the call as a json object specific to the event type.
+type Event struct {
+	GameId   GameId          `json:"game-id"`
+	Type     EventType       `json:"type"`
+	Time     time.Time       `json:"time"`
+	PlayerId PlayerId        `json:"player-id,omitempty"`
+	Version  int             `json:"version"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+func NewEvent(gameId GameId, eventType EventType, playerId PlayerId, payload any) (Event, error) {
+	event := Event{
+		GameId:   gameId,
+		Type:     eventType,
+		Time:     time.Now(),
+		PlayerId: playerId,
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Payload = data
+	}
+	return event, nil
+}
+
+func (e Event) DecodePayload(payload any) error {
+	if len(e.Payload) == 0 {
+		return ErrMissingEventPayload
+	}
+	return json.Unmarshal(e.Payload, payload)
+}
+
+// //////////////////////////////////////////////////
+// event payloads
+
+type CreateGamePayload struct {
+	User  UserSnapshot `json:"user"`
+	Setup GameSetup    `json:"setup"`
+}
+
+type JoinGamePayload struct {
+	User UserSnapshot `json:"user"`
+}
+
+type UpdateSettingsPayload struct {
+	TurnTimeout time.Duration `json:"turn-timeout,omitempty"`
+	// InviteCode of the game once private, recorded as it is generated at random.
+	InviteCode InviteCode `json:"invite-code,omitempty"`
+	ReadyCheck bool       `json:"ready-check,omitempty"`
+}
+
+type KickPlayerPayload struct {
+	PlayerId PlayerId `json:"player-id"`
+}
+
+type SetReadyPayload struct {
+	Ready bool `json:"ready"`
+}
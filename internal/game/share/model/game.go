@@ -1,7 +1,10 @@
 package model
 
 import (
+	"encoding/json"
 	"html/template"
+	"math/rand"
+	"sort"
 	"strings"
 	"time"
 
@@ -27,9 +30,34 @@ type Game[PlayerT Player] interface {
 	MarkForDeletion()
 
 	CreatedAt() time.Time
+	UpdatedAt() time.Time
+	SetUpdatedAt(updatedAt time.Time)
+
+	Version() int
+	SetVersion(version int)
+
+	Seed() int64
+	Random() *rand.Rand
+
+	Options() GameOptions
+	Settings() json.RawMessage
+	IsPrivate() bool
+	InviteCode() InviteCode
+	UpdateSettings(turnTimeout time.Duration, inviteCode InviteCode, readyCheck bool)
+
+	HostId() PlayerId
+	IsHost(playerId PlayerId) bool
+	SetHostId(playerId PlayerId)
+
+	TurnTimeout() time.Duration
+	TurnDeadline() time.Time
+	SetTurnDeadline(deadline time.Time)
 
 	CanJoin() bool
 	CanStart() bool
+	HasReadyCheck() bool
+	IsEveryoneReady() bool
+	IsReadyToStart() bool
 	UpdateJoinStatus()
 
 	Round() int
@@ -38,6 +66,7 @@ type Game[PlayerT Player] interface {
 	Order() [][]PlayerId
 	SetOrder(order [][]PlayerId)
 	SetRandomOrder()
+	SetRematchOrder() bool
 	OrderedPlayerIds(index int) []PlayerId
 	OrderedPlayers(index int) []PlayerT
 	OrderedPlayerId(index int) PlayerId
@@ -50,6 +79,7 @@ type Game[PlayerT Player] interface {
 	SetPlayingRoundPlayer()
 
 	HasPlayers() bool
+	HasHumanPlayers() bool
 	NbPlayer() int
 	Players() []PlayerT
 	FilterPlayers(filterFn func(player PlayerT) bool) []PlayerT
@@ -68,6 +98,7 @@ type Game[PlayerT Player] interface {
 	NonPlayingPlayers() []PlayerT
 	SetPlayingPlayer(playerIds ...PlayerId)
 
+	SetLowestScoreFirst(lowestScoreFirst bool)
 	RankIdFn(leftId, rightId PlayerId) RankResult
 	RankPlayerFn(left, right PlayerT) RankResult
 	RankPlayers()
@@ -79,12 +110,24 @@ type Game[PlayerT Player] interface {
 	SetWinners(winnerIds ...PlayerId)
 	SetTie()
 
+	IsRematch() bool
+	PreviousGameId() GameId
+	HasRematch() bool
+	RematchId() GameId
+	SetRematchId(rematchId GameId)
+	History() []TableResults
+	NbTableGame() int
+	NbTableWin(playerId PlayerId) int
+	NextRematch() Rematch
+
 	YourPlayerMessage(localizer loc.Localizer, playerId PlayerId) template.HTML
 	PlayerMessage(localizer loc.Localizer, playerId PlayerId) template.HTML
 	PlayerStatusIcon(playerId PlayerId) string
 
 	LabelSlice() []string
 	Labels() string
+
+	Snapshot() GameSnapshot
 }
 
 type RankResult int
@@ -96,13 +139,21 @@ const (
 )
 
 // //////////////////////////////////////////////////
-// game
+// base game
 
-func NewGame[PlayerT Player](minNbPlayer, maxNbPlayer int) Game[PlayerT] {
-	return &game[PlayerT]{
-		id:          GenerateGameId(),
+func NewGame[PlayerT Player](setup GameSetup, minNbPlayer, maxNbPlayer int) *BaseGame[PlayerT] {
+	source := newRandomSource(setup.Seed, 0)
+	g := &BaseGame[PlayerT]{
+		id:          setup.Id,
 		status:      GameStatus_JoinableNotStartable,
 		createdAt:   time.Now(),
+		seed:        setup.Seed,
+		source:      source,
+		random:      rand.New(source),
+		options:     setup.GameOptions,
+		settings:    setup.Settings,
+		inviteCode:  setup.InviteCode,
+		turnTimeout: setup.TurnTimeout,
 		minNbPlayer: minNbPlayer,
 		maxNbPlayer: maxNbPlayer,
 		players:     make(map[PlayerId]PlayerT),
@@ -110,73 +161,170 @@ func NewGame[PlayerT Player](minNbPlayer, maxNbPlayer int) Game[PlayerT] {
 		ranks:       make([][]PlayerId, 0),
 		round:       0,
 	}
-}
-
-type game[PlayerT Player] struct {
-	id          GameId
-	status      GameStatus
-	createdAt   time.Time
-	minNbPlayer int
-	maxNbPlayer int
-	players     map[PlayerId]PlayerT
-	round       int
-	order       [][]PlayerId
-	ranks       [][]PlayerId
-}
-
-func (g *game[PlayerT]) Id() GameId {
+	if setup.Rematch != nil {
+		g.previousGameId = setup.Rematch.PreviousGameId
+		g.seats = setup.Rematch.Seats
+		g.history = setup.Rematch.History
+	}
+	return g
+}
+
+// BaseGame implements the part of Game shared by every game.
+// Games embed a *BaseGame rather than the Game interface: since go 1.27, methods promoted from an embedded interface
+// are dropped by the linker when only generic code calls them ( "fatal error: unreachable method called. linker bug?" ).
+type BaseGame[PlayerT Player] struct {
+	id               GameId
+	status           GameStatus
+	createdAt        time.Time
+	updatedAt        time.Time
+	version          int
+	seed             int64
+	source           *randomSource
+	random           *rand.Rand
+	options          GameOptions
+	settings         json.RawMessage
+	inviteCode       InviteCode
+	turnTimeout      time.Duration
+	turnDeadline     time.Time
+	minNbPlayer      int
+	maxNbPlayer      int
+	lowestScoreFirst bool
+	players          map[PlayerId]PlayerT
+	round            int
+	order            [][]PlayerId
+	ranks            [][]PlayerId
+	hostId           PlayerId
+	previousGameId   GameId
+	seats            []UserId
+	history          []TableResults
+	rematchId        GameId
+}
+
+func (g *BaseGame[PlayerT]) Id() GameId {
 	return g.id
 }
 
-func (g *game[PlayerT]) IsStarted() bool {
+func (g *BaseGame[PlayerT]) IsStarted() bool {
 	return g.Status().IsStarted()
 }
 
-func (g *game[PlayerT]) IsStopped() bool {
+func (g *BaseGame[PlayerT]) IsStopped() bool {
 	return g.Status().IsStopped()
 }
 
-func (g *game[PlayerT]) WasStarted() bool {
+func (g *BaseGame[PlayerT]) WasStarted() bool {
 	return g.IsStarted() || g.IsStopped()
 }
 
-func (g *game[PlayerT]) Status() GameStatus {
+func (g *BaseGame[PlayerT]) Status() GameStatus {
 	return g.status
 }
 
-func (g *game[PlayerT]) SetStatus(status GameStatus) {
+func (g *BaseGame[PlayerT]) SetStatus(status GameStatus) {
 	g.status = status
 }
 
-func (g *game[PlayerT]) SetStarted() {
+func (g *BaseGame[PlayerT]) SetStarted() {
 	g.status = GameStatus_Started
 }
 
-func (g *game[PlayerT]) SetStopped() {
+func (g *BaseGame[PlayerT]) SetStopped() {
 	g.status = GameStatus_Stopped
 }
 
-func (g *game[PlayerT]) IsMarkedForDeletion() bool {
+func (g *BaseGame[PlayerT]) IsMarkedForDeletion() bool {
 	return g.status == GameStatus_MarkedForDeletion
 }
 
-func (g *game[PlayerT]) MarkForDeletion() {
+func (g *BaseGame[PlayerT]) MarkForDeletion() {
 	g.SetStatus(GameStatus_MarkedForDeletion)
 }
 
-func (g *game[PlayerT]) CreatedAt() time.Time {
+func (g *BaseGame[PlayerT]) CreatedAt() time.Time {
 	return g.createdAt
 }
 
-func (g *game[PlayerT]) CanJoin() bool {
+// UpdatedAt is the last time the game was stored, zero for a game never stored.
+func (g *BaseGame[PlayerT]) UpdatedAt() time.Time {
+	return g.updatedAt
+}
+
+func (g *BaseGame[PlayerT]) SetUpdatedAt(updatedAt time.Time) {
+	g.updatedAt = updatedAt
+}
+
+func (g *BaseGame[PlayerT]) Version() int {
+	return g.version
+}
+
+func (g *BaseGame[PlayerT]) SetVersion(version int) {
+	g.version = version
+}
+
+func (g *BaseGame[PlayerT]) Seed() int64 {
+	return g.seed
+}
+
+// Random is the game random generator: every random draw of the game must use it so that the game can be reproduced from its seed.
+func (g *BaseGame[PlayerT]) Random() *rand.Rand {
+	return g.random
+}
+
+// Options are the options the game was created with, given again to its rematch.
+func (g *BaseGame[PlayerT]) Options() GameOptions {
+	return g.options
+}
+
+// Settings are the settings specific to the game it was created with, given again to its rematch.
+func (g *BaseGame[PlayerT]) Settings() json.RawMessage {
+	return g.settings
+}
+
+func (g *BaseGame[PlayerT]) IsPrivate() bool {
+	return g.inviteCode != ""
+}
+
+func (g *BaseGame[PlayerT]) InviteCode() InviteCode {
+	return g.inviteCode
+}
+
+func (g *BaseGame[PlayerT]) TurnTimeout() time.Duration {
+	return g.turnTimeout
+}
+
+func (g *BaseGame[PlayerT]) TurnDeadline() time.Time {
+	return g.turnDeadline
+}
+
+func (g *BaseGame[PlayerT]) SetTurnDeadline(deadline time.Time) {
+	g.turnDeadline = deadline
+}
+
+func (g *BaseGame[PlayerT]) CanJoin() bool {
 	return g.maxNbPlayer == 0 || len(g.players) < g.maxNbPlayer
 }
 
-func (g *game[PlayerT]) CanStart() bool {
+func (g *BaseGame[PlayerT]) CanStart() bool {
 	return len(g.players) >= g.minNbPlayer
 }
 
-func (g *game[PlayerT]) UpdateJoinStatus() {
+func (g *BaseGame[PlayerT]) HasReadyCheck() bool {
+	return g.options.ReadyCheck
+}
+
+func (g *BaseGame[PlayerT]) IsEveryoneReady() bool {
+	_, found := dict.First(g.players, func(player PlayerT) bool {
+		return !player.IsReady()
+	})
+	return !found
+}
+
+// IsReadyToStart tells whether the game has enough players, all of them ready when the game has a ready check.
+func (g *BaseGame[PlayerT]) IsReadyToStart() bool {
+	return g.CanStart() && (!g.HasReadyCheck() || g.IsEveryoneReady())
+}
+
+func (g *BaseGame[PlayerT]) UpdateJoinStatus() {
 	if g.WasStarted() || g.IsMarkedForDeletion() {
 		return
 	}
@@ -198,28 +346,28 @@ func (g *game[PlayerT]) UpdateJoinStatus() {
 	}
 }
 
-func (g *game[PlayerT]) Start() {
+func (g *BaseGame[PlayerT]) Start() {
 	g.SetStatus(GameStatus_Started)
 
 }
 
-func (g *game[PlayerT]) Round() int {
+func (g *BaseGame[PlayerT]) Round() int {
 	return g.round
 }
 
-func (g *game[PlayerT]) FirstRound() {
+func (g *BaseGame[PlayerT]) FirstRound() {
 	g.round = 1
 }
 
-func (g *game[PlayerT]) NextRound() {
+func (g *BaseGame[PlayerT]) NextRound() {
 	g.round++
 }
 
-func (g *game[PlayerT]) Order() [][]PlayerId {
+func (g *BaseGame[PlayerT]) Order() [][]PlayerId {
 	return g.order
 }
 
-func (g *game[PlayerT]) SetOrder(order [][]PlayerId) {
+func (g *BaseGame[PlayerT]) SetOrder(order [][]PlayerId) {
 	for _, round := range order {
 		for _, playerId := range round {
 			if !g.HasPlayer(playerId) {
@@ -230,9 +378,10 @@ func (g *game[PlayerT]) SetOrder(order [][]PlayerId) {
 	g.order = order
 }
 
-func (g *game[PlayerT]) SetRandomOrder() {
+func (g *BaseGame[PlayerT]) SetRandomOrder() {
 	ids := dict.ConvertToList(g.players, dict.Key)
-	list.Shuffle(ids)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	list.Shuffle(g.random, ids)
 	order := make([][]PlayerId, 0, len(ids))
 	for _, id := range ids {
 		order = append(order, []PlayerId{id})
@@ -240,18 +389,18 @@ func (g *game[PlayerT]) SetRandomOrder() {
 	g.SetOrder(order)
 }
 
-func (g *game[PlayerT]) OrderedPlayerIds(index int) []PlayerId {
+func (g *BaseGame[PlayerT]) OrderedPlayerIds(index int) []PlayerId {
 	return g.order[index%len(g.order)]
 }
 
-func (g *game[PlayerT]) OrderedPlayers(index int) []PlayerT {
+func (g *BaseGame[PlayerT]) OrderedPlayers(index int) []PlayerT {
 	playerIds := g.OrderedPlayerIds(index)
 	return list.Convert(playerIds, func(playerId PlayerId) PlayerT {
 		return g.MustPlayer(playerId)
 	})
 }
 
-func (g *game[PlayerT]) OrderedPlayerId(index int) PlayerId {
+func (g *BaseGame[PlayerT]) OrderedPlayerId(index int) PlayerId {
 	playerIds := g.OrderedPlayerIds(index)
 	if len(playerIds) == 0 {
 		panic(ErrPlayerNotFound)
@@ -259,17 +408,17 @@ func (g *game[PlayerT]) OrderedPlayerId(index int) PlayerId {
 	return playerIds[0]
 }
 
-func (g *game[PlayerT]) OrderedPlayer(index int) PlayerT {
+func (g *BaseGame[PlayerT]) OrderedPlayer(index int) PlayerT {
 	playerId := g.OrderedPlayerId(index)
 	return g.MustPlayer(playerId)
 }
 
-func (g *game[PlayerT]) RoundPlayerIds() []PlayerId {
+func (g *BaseGame[PlayerT]) RoundPlayerIds() []PlayerId {
 	orderIndex := g.Round() % g.NbPlayer()
 	return g.order[orderIndex]
 }
 
-func (g *game[PlayerT]) RoundPlayerId() PlayerId {
+func (g *BaseGame[PlayerT]) RoundPlayerId() PlayerId {
 	playerIds := g.RoundPlayerIds()
 	if len(playerIds) == 0 {
 		panic(ErrPlayerNotFound)
@@ -277,63 +426,74 @@ func (g *game[PlayerT]) RoundPlayerId() PlayerId {
 	return playerIds[0]
 }
 
-func (g *game[PlayerT]) RoundPlayers() []PlayerT {
+func (g *BaseGame[PlayerT]) RoundPlayers() []PlayerT {
 	playerIds := g.RoundPlayerIds()
 	return list.Convert(playerIds, func(playerId PlayerId) PlayerT {
 		return g.MustPlayer(playerId)
 	})
 }
 
-func (g *game[PlayerT]) RoundPlayer() PlayerT {
+func (g *BaseGame[PlayerT]) RoundPlayer() PlayerT {
 	playerId := g.RoundPlayerId()
 	return g.MustPlayer(playerId)
 }
 
-func (g *game[PlayerT]) SetPlayingRoundPlayers() {
+func (g *BaseGame[PlayerT]) SetPlayingRoundPlayers() {
 	g.SetPlayingPlayer(g.RoundPlayerIds()...)
 }
 
-func (g *game[PlayerT]) SetPlayingRoundPlayer() {
+func (g *BaseGame[PlayerT]) SetPlayingRoundPlayer() {
 	g.SetPlayingRoundPlayers()
 }
 
-func (g *game[PlayerT]) Stop() {
+func (g *BaseGame[PlayerT]) Stop() {
 	g.SetStatus(GameStatus_Stopped)
 	g.round = 0
 }
 
-func (g *game[PlayerT]) HasPlayers() bool {
+func (g *BaseGame[PlayerT]) HasPlayers() bool {
 	return len(g.players) > 0
 }
 
-func (g *game[PlayerT]) NbPlayer() int {
+// HasHumanPlayers tells whether at least one player is not a bot.
+func (g *BaseGame[PlayerT]) HasHumanPlayers() bool {
+	_, found := dict.First(g.players, func(player PlayerT) bool {
+		return !player.IsBot()
+	})
+	return found
+}
+
+func (g *BaseGame[PlayerT]) NbPlayer() int {
 	return len(g.players)
 }
 
-func (g *game[PlayerT]) Players() []PlayerT {
+func (g *BaseGame[PlayerT]) Players() []PlayerT {
 	return dict.Values(g.players)
 }
 
-func (g *game[PlayerT]) FilterPlayers(filterFn func(player PlayerT) bool) []PlayerT {
+func (g *BaseGame[PlayerT]) FilterPlayers(filterFn func(player PlayerT) bool) []PlayerT {
 	return dict.Filter(g.players, filterFn)
 }
 
-func (g *game[PlayerT]) AttachPlayer(player PlayerT) {
+func (g *BaseGame[PlayerT]) AttachPlayer(player PlayerT) {
 	if player.Id().GameId() != g.Id() {
 		panic(ErrWrongPlayer)
 	}
 	g.players[player.Id()] = player
 }
 
-func (g *game[PlayerT]) DetachPlayer(player PlayerT) {
+func (g *BaseGame[PlayerT]) DetachPlayer(player PlayerT) {
 	if player.Id().GameId() != g.Id() {
 		panic(ErrWrongPlayer)
 	}
 	delete(g.players, player.Id())
 	player.SetStatus(PlayerStatus_WaitingToJoin)
+	if g.IsHost(player.Id()) {
+		g.passHost()
+	}
 }
 
-func (g *game[PlayerT]) HasUser(userId UserId) bool {
+func (g *BaseGame[PlayerT]) HasUser(userId UserId) bool {
 	for playerId := range g.players {
 		if playerId.MatchUser(userId) {
 			return true
@@ -342,15 +502,15 @@ func (g *game[PlayerT]) HasUser(userId UserId) bool {
 	return false
 }
 
-func (g *game[PlayerT]) HasPlayer(playerId PlayerId) bool {
+func (g *BaseGame[PlayerT]) HasPlayer(playerId PlayerId) bool {
 	return dict.ContainsKey(g.players, playerId)
 }
 
-func (g *game[PlayerT]) Player(id PlayerId) (PlayerT, bool) {
+func (g *BaseGame[PlayerT]) Player(id PlayerId) (PlayerT, bool) {
 	return dict.Get(g.players, id)
 }
 
-func (g *game[PlayerT]) MustPlayer(id PlayerId) PlayerT {
+func (g *BaseGame[PlayerT]) MustPlayer(id PlayerId) PlayerT {
 	player, found := g.Player(id)
 	if !found {
 		panic(ErrPlayerNotFound)
@@ -358,19 +518,23 @@ func (g *game[PlayerT]) MustPlayer(id PlayerId) PlayerT {
 	return player
 }
 
-func (g *game[PlayerT]) PlayerLabelSlice(id PlayerId) []string {
+func (g *BaseGame[PlayerT]) PlayerLabelSlice(id PlayerId) []string {
 	player, found := g.Player(id)
 	if !found {
 		return []string{"error"}
 	}
-	return player.LabelSlice()
+	labels := player.LabelSlice()
+	if g.IsHost(id) {
+		labels = append(labels, "host")
+	}
+	return labels
 }
 
-func (g *game[PlayerT]) PlayerLabels(id PlayerId) string {
+func (g *BaseGame[PlayerT]) PlayerLabels(id PlayerId) string {
 	return strings.Join(g.PlayerLabelSlice(id), " ")
 }
 
-func (g *game[PlayerT]) IsPlayingPlayer(playerId PlayerId) bool {
+func (g *BaseGame[PlayerT]) IsPlayingPlayer(playerId PlayerId) bool {
 	player, found := g.Player(playerId)
 	if !found {
 		return false
@@ -378,25 +542,25 @@ func (g *game[PlayerT]) IsPlayingPlayer(playerId PlayerId) bool {
 	return player.IsPlaying()
 }
 
-func (g *game[PlayerT]) PlayingPlayer() (PlayerT, bool) {
+func (g *BaseGame[PlayerT]) PlayingPlayer() (PlayerT, bool) {
 	return dict.First(g.players, func(player PlayerT) bool {
 		return player.Status().IsPlaying()
 	})
 }
 
-func (g *game[PlayerT]) PlayingPlayers() []PlayerT {
+func (g *BaseGame[PlayerT]) PlayingPlayers() []PlayerT {
 	return dict.Filter(g.players, func(player PlayerT) bool {
 		return player.Status().IsPlaying()
 	})
 }
 
-func (g *game[PlayerT]) NonPlayingPlayers() []PlayerT {
+func (g *BaseGame[PlayerT]) NonPlayingPlayers() []PlayerT {
 	return dict.Filter(g.players, func(player PlayerT) bool {
 		return player.Status().IsWaitingToPlay()
 	})
 }
 
-func (g *game[PlayerT]) SetPlayingPlayer(playerIds ...PlayerId) {
+func (g *BaseGame[PlayerT]) SetPlayingPlayer(playerIds ...PlayerId) {
 	count := 0
 	for _, player := range g.players {
 		if list.Contains(playerIds, player.Id()) {
@@ -411,7 +575,12 @@ func (g *game[PlayerT]) SetPlayingPlayer(playerIds ...PlayerId) {
 	}
 }
 
-func (g *game[PlayerT]) RankIdFn(leftId, rightId PlayerId) RankResult {
+// SetLowestScoreFirst ranks players with the lowest score first, for games where points are penalties.
+func (g *BaseGame[PlayerT]) SetLowestScoreFirst(lowestScoreFirst bool) {
+	g.lowestScoreFirst = lowestScoreFirst
+}
+
+func (g *BaseGame[PlayerT]) RankIdFn(leftId, rightId PlayerId) RankResult {
 	left, leftFound := g.Player(leftId)
 	right, rightFound := g.Player(rightId)
 	if !leftFound && !rightFound {
@@ -425,9 +594,12 @@ func (g *game[PlayerT]) RankIdFn(leftId, rightId PlayerId) RankResult {
 	}
 }
 
-func (g *game[PlayerT]) RankPlayerFn(left, right PlayerT) RankResult {
+func (g *BaseGame[PlayerT]) RankPlayerFn(left, right PlayerT) RankResult {
 	leftScore := left.Score()
 	rightScore := right.Score()
+	if g.lowestScoreFirst {
+		leftScore, rightScore = rightScore, leftScore
+	}
 	if leftScore > rightScore {
 		return RankResult_Left
 	} else if rightScore > leftScore {
@@ -437,7 +609,7 @@ func (g *game[PlayerT]) RankPlayerFn(left, right PlayerT) RankResult {
 	}
 }
 
-func (g *game[PlayerT]) RankPlayers() {
+func (g *BaseGame[PlayerT]) RankPlayers() {
 
 	ranks := make([][]PlayerId, 0, len(g.players))
 	for leftId := range g.players {
@@ -452,7 +624,6 @@ func (g *game[PlayerT]) RankPlayers() {
 					ranks = append([][]PlayerId{{leftId}}, ranks...)
 				}
 				added = true
-				continue
 			case RankResult_Right:
 				continue
 			case RankResult_Equal:
@@ -479,18 +650,18 @@ func (g *game[PlayerT]) RankPlayers() {
 	}
 }
 
-func (g *game[PlayerT]) UpdatePlayerScoreFn(player PlayerT) {
+func (g *BaseGame[PlayerT]) UpdatePlayerScoreFn(player PlayerT) {
 	player.UnsetScore()
 }
 
-func (g *game[PlayerT]) UpdateScores() {
+func (g *BaseGame[PlayerT]) UpdateScores() {
 	for _, player := range g.players {
 		g.UpdatePlayerScoreFn(player)
 	}
 	g.RankPlayers()
 }
 
-func (g *game[PlayerT]) SetLoosers(looserIds ...PlayerId) {
+func (g *BaseGame[PlayerT]) SetLoosers(looserIds ...PlayerId) {
 	for playerId, player := range g.players {
 		if list.Contains(looserIds, playerId) {
 			player.SetLoose()
@@ -500,7 +671,7 @@ func (g *game[PlayerT]) SetLoosers(looserIds ...PlayerId) {
 	}
 }
 
-func (g *game[PlayerT]) SetWinners(winnerIds ...PlayerId) {
+func (g *BaseGame[PlayerT]) SetWinners(winnerIds ...PlayerId) {
 	for playerId, player := range g.players {
 		if list.Contains(winnerIds, playerId) {
 			player.SetWin()
@@ -510,14 +681,14 @@ func (g *game[PlayerT]) SetWinners(winnerIds ...PlayerId) {
 	}
 }
 
-func (g *game[PlayerT]) SetTie() {
+func (g *BaseGame[PlayerT]) SetTie() {
 	for _, player := range g.players {
 		player.SetTie()
 	}
 
 }
 
-func (g *game[PlayerT]) YourPlayerMessage(localizer loc.Localizer, playerId PlayerId) template.HTML {
+func (g *BaseGame[PlayerT]) YourPlayerMessage(localizer loc.Localizer, playerId PlayerId) template.HTML {
 	player, found := g.Player(playerId)
 	if !found {
 		return localizer.Loc("Error", ErrPlayerNotFound.Error())
@@ -536,7 +707,7 @@ func (g *game[PlayerT]) YourPlayerMessage(localizer loc.Localizer, playerId Play
 	return player.Status().YourMessage(localizer)
 }
 
-func (g *game[PlayerT]) PlayerMessage(localizer loc.Localizer, playerId PlayerId) template.HTML {
+func (g *BaseGame[PlayerT]) PlayerMessage(localizer loc.Localizer, playerId PlayerId) template.HTML {
 	player, found := g.Player(playerId)
 	if !found {
 		return localizer.Loc("Error", ErrPlayerNotFound.Error())
@@ -552,10 +723,10 @@ func (g *game[PlayerT]) PlayerMessage(localizer loc.Localizer, playerId PlayerId
 			return localizer.Loc("PlayerLoose")
 		}
 	}
-	return player.Status().Message(localizer)
+	return player.VisibleStatus().Message(localizer)
 }
 
-func (g *game[PlayerT]) PlayerStatusIcon(playerId PlayerId) string {
+func (g *BaseGame[PlayerT]) PlayerStatusIcon(playerId PlayerId) string {
 	player, found := g.Player(playerId)
 	if !found {
 		return ""
@@ -563,16 +734,19 @@ func (g *game[PlayerT]) PlayerStatusIcon(playerId PlayerId) string {
 	if player.HasResult() {
 		return player.Result().Icon()
 	}
-	return player.Status().Icon()
+	return player.VisibleStatus().Icon()
 }
 
-func (g *game[PlayerT]) LabelSlice() []string {
+func (g *BaseGame[PlayerT]) LabelSlice() []string {
 	labels := make([]string, 0)
 	labels = append(labels, "game")
 	labels = append(labels, g.status.Labels()...)
+	if g.IsPrivate() {
+		labels = append(labels, "private")
+	}
 	return labels
 }
 
-func (g *game[PlayerT]) Labels() string {
+func (g *BaseGame[PlayerT]) Labels() string {
 	return strings.Join(g.LabelSlice(), " ")
 }
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+)
+
+// //////////////////////////////////////////////////
+// game options
+
+// GameOptions are chosen by the user creating the game, whatever the game.
+// Options specific to a game are given as settings ( see GameSetup ).
+type GameOptions struct {
+	// TurnTimeout is the time given to a player to play his turn ( zero means no limit ).
+	TurnTimeout time.Duration `json:"turn-timeout,omitempty"`
+	// Private games are hidden from the lobby and only joined through their invite code.
+	Private bool `json:"private,omitempty"`
+	// ReadyCheck games only start once every player is ready.
+	ReadyCheck bool `json:"ready-check,omitempty"`
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// //////////////////////////////////////////////////
+// game setup
+
+// GameSetup holds what is decided when a game is created.
+// It is recorded with the create-game event so that a replayed game is created identically.
+type GameSetup struct {
+	GameOptions
+	Id GameId `json:"id"`
+	// Seed of the game random generator: the same seed gives the same order & deals.
+	Seed int64 `json:"seed"`
+	// InviteCode of a private game.
+	InviteCode InviteCode `json:"invite-code,omitempty"`
+	// Rematch links the game to the previous game of its table ( nil for a new table ).
+	Rematch *Rematch `json:"rematch,omitempty"`
+	// Settings are the options specific to the game ( e.g. the size of the board ), as a json object decoded by the game itself.
+	Settings json.RawMessage `json:"settings,omitempty"`
+}
+
+func NewGameSetup(options GameOptions) GameSetup {
+	setup := GameSetup{
+		GameOptions: options,
+		Id:          GenerateGameId(),
+		Seed:        time.Now().UnixNano(),
+	}
+	if options.Private {
+		setup.InviteCode = GenerateInviteCode()
+	}
+	return setup
+}
+
+// EncodeSettings turns the settings specific to a game into a json object ( nil settings giving none ).
+func EncodeSettings(settings any) (json.RawMessage, error) {
+	if settings == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DecodeSettings reads the settings specific to the game, leaving them untouched when there are none.
+func (s GameSetup) DecodeSettings(settings any) error {
+	if len(s.Settings) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(s.Settings, settings); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSettings, err)
+	}
+	return nil
+}
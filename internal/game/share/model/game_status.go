@@ -161,6 +161,21 @@ func (s GameStatus) String() string {
 	}
 }
 
+func ParseGameStatus(value string) (GameStatus, error) {
+	for _, status := range []GameStatus{
+		GameStatus_JoinableNotStartable,
+		GameStatus_JoinableAndStartable,
+		GameStatus_NotJoinableAndStartable,
+		GameStatus_Started,
+		GameStatus_Stopped,
+	} {
+		if status.String() == value {
+			return status, nil
+		}
+	}
+	return 0, ErrInvalidGameStatus
+}
+
 func (s GameStatus) Labels() []string {
 	var labels []string
 	labels = append(labels, "game-status")
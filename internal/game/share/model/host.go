@@ -0,0 +1,47 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// //////////////////////////////////////////////////
+// host
+
+// HostId is the player who created the game, or the one the game was passed on to when the host left.
+func (g *BaseGame[PlayerT]) HostId() PlayerId {
+	return g.hostId
+}
+
+func (g *BaseGame[PlayerT]) IsHost(playerId PlayerId) bool {
+	return g.hostId != "" && g.hostId == playerId
+}
+
+func (g *BaseGame[PlayerT]) SetHostId(playerId PlayerId) {
+	g.hostId = playerId
+}
+
+// passHost passes the game on to the remaining human player with the lowest id, so that replays pass it on the same way.
+// A game left to bots has no host.
+func (g *BaseGame[PlayerT]) passHost() {
+	ids := make([]PlayerId, 0, len(g.players))
+	for playerId, player := range g.players {
+		if !player.IsBot() {
+			ids = append(ids, playerId)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	g.hostId = ""
+	if len(ids) > 0 {
+		g.hostId = ids[0]
+	}
+}
+
+// UpdateSettings changes the options the host can still change in the lobby: the turn timeout, the privacy of the game & the ready check.
+func (g *BaseGame[PlayerT]) UpdateSettings(turnTimeout time.Duration, inviteCode InviteCode, readyCheck bool) {
+	g.turnTimeout = turnTimeout
+	g.inviteCode = inviteCode
+	g.options.TurnTimeout = turnTimeout
+	g.options.Private = inviteCode != ""
+	g.options.ReadyCheck = readyCheck
+}
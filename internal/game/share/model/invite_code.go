@@ -0,0 +1,11 @@
+package model
+
+// //////////////////////////////////////////////////
+// invite code
+
+// InviteCode gives access to a private game, which is not listed in the lobby.
+type InviteCode string
+
+var GenerateInviteCode = func() InviteCode {
+	return InviteCode(gameIdGenerateFn())
+}
@@ -15,9 +15,18 @@ type Player interface {
 
 	GameId() GameId
 
+	IsBot() bool
+
 	IsPlaying() bool
 	Status() PlayerStatus
 	SetStatus(status PlayerStatus)
+	VisibleStatus() PlayerStatus
+
+	IsReconnecting() bool
+	SetReconnecting(reconnecting bool)
+
+	IsReady() bool
+	SetReady(ready bool)
 
 	HasScore() bool
 	Score() PlayerScore
@@ -41,142 +50,183 @@ type Player interface {
 
 	LabelSlice() []string
 	Labels() string
+
+	Snapshot() PlayerSnapshot
 }
 
 // //////////////////////////////////////////////////
 // base player
 
-type player struct {
-	user     User
-	id       PlayerId
-	status   PlayerStatus
-	gameId   GameId
-	hasScore bool
-	score    PlayerScore
-	rank     PlayerRank
-	result   PlayerResult
-}
-
-func NewPlayer(gameId GameId, userId UserId) Player {
-	return &player{
+// BasePlayer implements the part of Player shared by every game, embedded by the players of each game ( see BaseGame ).
+type BasePlayer struct {
+	user         User
+	id           PlayerId
+	status       PlayerStatus
+	reconnecting bool
+	ready        bool
+	gameId       GameId
+	hasScore     bool
+	score        PlayerScore
+	rank         PlayerRank
+	result       PlayerResult
+}
+
+func NewPlayer(gameId GameId, userId UserId) *BasePlayer {
+	return &BasePlayer{
 		user:   NewUser(userId),
 		id:     NewPlayerId(gameId, userId),
 		gameId: gameId,
 	}
 }
 
-func NewPlayerFromUser(gameId GameId, user User) Player {
-	return &player{
+func NewPlayerFromUser(gameId GameId, user User) *BasePlayer {
+	return &BasePlayer{
 		user:   NewUserFromUser(user),
 		id:     NewPlayerId(gameId, user.Id()),
 		gameId: gameId,
 	}
 }
 
-func (p *player) User() User {
+func (p *BasePlayer) User() User {
 	return p.user
 }
 
-func (p *player) HasId() bool {
+func (p *BasePlayer) HasId() bool {
 	return p.id != ""
 }
 
-func (p *player) Id() PlayerId {
+func (p *BasePlayer) Id() PlayerId {
 	return p.id
 }
 
-func (p *player) IsPlaying() bool {
+func (p *BasePlayer) IsBot() bool {
+	return p.user.IsBot()
+}
+
+func (p *BasePlayer) IsPlaying() bool {
 	return p.status.IsPlaying()
 }
 
-func (p *player) Status() PlayerStatus {
+func (p *BasePlayer) Status() PlayerStatus {
 	return p.status
 }
 
-func (p *player) SetStatus(status PlayerStatus) {
+func (p *BasePlayer) SetStatus(status PlayerStatus) {
 	p.status = status
 }
 
-func (p *player) GameId() GameId {
+// VisibleStatus is the status shown to the other players: reconnecting while the connection of the player is down,
+// ready once the player is ready for the game to start.
+func (p *BasePlayer) VisibleStatus() PlayerStatus {
+	if p.reconnecting {
+		return PlayerStatus_Reconnecting
+	}
+	if p.ready && (p.status.IsWaitingToJoin() || p.status.IsWaitingToStart()) {
+		return PlayerStatus_Ready
+	}
+	return p.status
+}
+
+func (p *BasePlayer) IsReconnecting() bool {
+	return p.reconnecting
+}
+
+func (p *BasePlayer) SetReconnecting(reconnecting bool) {
+	p.reconnecting = reconnecting
+}
+
+// IsReady tells whether the player is ready for the game to start: bots always are.
+func (p *BasePlayer) IsReady() bool {
+	return p.ready || p.IsBot()
+}
+
+func (p *BasePlayer) SetReady(ready bool) {
+	p.ready = ready
+}
+
+func (p *BasePlayer) GameId() GameId {
 	return p.gameId
 }
 
-func (p *player) HasScore() bool {
+func (p *BasePlayer) HasScore() bool {
 	return p.hasScore
 }
 
-func (p *player) Score() PlayerScore {
+func (p *BasePlayer) Score() PlayerScore {
 	return p.score
 }
 
-func (p *player) SetScore(score PlayerScore) {
+func (p *BasePlayer) SetScore(score PlayerScore) {
 	p.hasScore = true
 	p.score = score
 }
 
-func (p *player) AddScore(score PlayerScore) {
+func (p *BasePlayer) AddScore(score PlayerScore) {
 	p.hasScore = true
 	p.score += score
 }
 
-func (p *player) RemoveScore(score PlayerScore) {
+func (p *BasePlayer) RemoveScore(score PlayerScore) {
 	p.hasScore = true
 	p.score -= score
 }
 
-func (p *player) UnsetScore() {
+func (p *BasePlayer) UnsetScore() {
 	p.hasScore = false
 	p.score = 0
 }
 
-func (p *player) HasRank() bool {
+func (p *BasePlayer) HasRank() bool {
 	return p.rank != 0
 }
 
-func (p *player) Rank() PlayerRank {
+func (p *BasePlayer) Rank() PlayerRank {
 	return p.rank
 }
 
-func (p *player) SetRank(rank PlayerRank) {
+func (p *BasePlayer) SetRank(rank PlayerRank) {
 	p.rank = rank
 }
 
-func (p *player) UnsetRank() {
+func (p *BasePlayer) UnsetRank() {
 	p.rank = 0
 }
 
-func (p *player) HasResult() bool {
+func (p *BasePlayer) HasResult() bool {
 	return p.result != 0
 }
 
-func (p *player) Result() PlayerResult {
+func (p *BasePlayer) Result() PlayerResult {
 	return p.result
 }
 
-func (p *player) SetResult(result PlayerResult) {
+func (p *BasePlayer) SetResult(result PlayerResult) {
 	p.result = result
 }
 
-func (p *player) SetWin() {
+func (p *BasePlayer) SetWin() {
 	p.result = PlayerResult_Win
 }
 
-func (p *player) SetTie() {
+func (p *BasePlayer) SetTie() {
 	p.result = PlayerResult_Tie
 }
 
-func (p *player) SetLoose() {
+func (p *BasePlayer) SetLoose() {
 	p.result = PlayerResult_Loose
 }
 
-func (p *player) UnsetResult() {
+func (p *BasePlayer) UnsetResult() {
 	p.result = PlayerResult_Unknown
 }
 
-func (p *player) LabelSlice() []string {
+func (p *BasePlayer) LabelSlice() []string {
 	labels := make([]string, 0)
 	labels = append(labels, "player")
-	labels = append(labels, p.Status().LabelSlice()...)
+	if p.IsBot() {
+		labels = append(labels, "bot")
+	}
+	labels = append(labels, p.VisibleStatus().LabelSlice()...)
 	if p.HasRank() {
 		labels = append(labels, p.Rank().LabelSlice()...)
 	}
@@ -189,6 +239,6 @@ func (p *player) LabelSlice() []string {
 	return labels
 }
 
-func (p *player) Labels() string {
+func (p *BasePlayer) Labels() string {
 	return strings.Join(p.LabelSlice(), " ")
 }
@@ -15,6 +15,8 @@ const (
 	PlayerStatus_WaitingToPlay
 	PlayerStatus_Playing
 	PlayerStatus_Played
+	PlayerStatus_Reconnecting
+	PlayerStatus_Ready
 )
 
 func (s PlayerStatus) IsWaitingToJoin() bool {
@@ -37,13 +39,23 @@ func (s PlayerStatus) HasPlayed() bool {
 	return s == PlayerStatus_Played
 }
 
+func (s PlayerStatus) IsReconnecting() bool {
+	return s == PlayerStatus_Reconnecting
+}
+
+func (s PlayerStatus) IsReady() bool {
+	return s == PlayerStatus_Ready
+}
+
 func (s PlayerStatus) IsValid() bool {
 	switch s {
 	case PlayerStatus_WaitingToJoin,
 		PlayerStatus_WaitingToStart,
 		PlayerStatus_WaitingToPlay,
 		PlayerStatus_Playing,
-		PlayerStatus_Played:
+		PlayerStatus_Played,
+		PlayerStatus_Reconnecting,
+		PlayerStatus_Ready:
 		return true
 	default:
 		return false
@@ -62,6 +74,10 @@ func (s PlayerStatus) String() string {
 		return "playing"
 	case PlayerStatus_Played:
 		return "played"
+	case PlayerStatus_Reconnecting:
+		return "reconnecting"
+	case PlayerStatus_Ready:
+		return "ready"
 	default:
 		return ""
 	}
@@ -79,6 +95,10 @@ func (s PlayerStatus) YourMessage(localizer loc.Localizer) template.HTML {
 		return localizer.Loc("YouPlaying")
 	case PlayerStatus_Played:
 		return localizer.Loc("YouPlayed")
+	case PlayerStatus_Reconnecting:
+		return localizer.Loc("YouReconnecting")
+	case PlayerStatus_Ready:
+		return localizer.Loc("YouReady")
 	}
 	return ""
 }
@@ -95,6 +115,10 @@ func (s PlayerStatus) Message(localizer loc.Localizer) template.HTML {
 		return localizer.Loc("PlayerPlaying")
 	case PlayerStatus_Played:
 		return localizer.Loc("PlayerPlayed")
+	case PlayerStatus_Reconnecting:
+		return localizer.Loc("PlayerReconnecting")
+	case PlayerStatus_Ready:
+		return localizer.Loc("PlayerReady")
 	}
 	return ""
 }
@@ -117,10 +141,13 @@ func (s PlayerStatus) Icon() string {
 	case PlayerStatus_WaitingToJoin,
 		PlayerStatus_WaitingToStart,
 		PlayerStatus_WaitingToPlay,
-		PlayerStatus_Played:
+		PlayerStatus_Played,
+		PlayerStatus_Reconnecting:
 		return "icon-pause"
 	case PlayerStatus_Playing:
 		return "icon-play"
+	case PlayerStatus_Ready:
+		return "icon-valid"
 	}
 	return ""
 }
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"math/rand"
+)
+
+// //////////////////////////////////////////////////
+// random source
+
+// randomSource counts the values drawn from a seeded source
+// so that its state can be restored from the seed and the number of draws.
+type randomSource struct {
+	source rand.Source64
+	nbDraw int64
+}
+
+func newRandomSource(seed int64, nbDraw int64) *randomSource {
+	s := &randomSource{
+		source: rand.NewSource(seed).(rand.Source64),
+	}
+	for s.nbDraw < nbDraw {
+		s.Int63()
+	}
+	return s
+}
+
+func (s *randomSource) Int63() int64 {
+	s.nbDraw++
+	return s.source.Int63()
+}
+
+func (s *randomSource) Uint64() uint64 {
+	s.nbDraw++
+	return s.source.Uint64()
+}
+
+func (s *randomSource) Seed(seed int64) {
+	s.source.Seed(seed)
+	s.nbDraw = 0
+}
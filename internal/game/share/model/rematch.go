@@ -0,0 +1,135 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/gre-ory/games-go/internal/util/list"
+)
+
+// //////////////////////////////////////////////////
+// rematch
+
+// Rematch links a game to the previous game played at the same table.
+// It is part of the setup of the new game, so that a replayed rematch is seated identically.
+type Rematch struct {
+	PreviousGameId GameId `json:"previous-game-id"`
+	// Seats of the users, in the order of the previous game rotated by one.
+	Seats []UserId `json:"seats"`
+	// History of the results of the previous games of the table, oldest first.
+	History []TableResults `json:"history,omitempty"`
+}
+
+// TableResults are the results of the users in one game of a table.
+type TableResults map[UserId]PlayerResult
+
+func (g *BaseGame[PlayerT]) IsRematch() bool {
+	return g.previousGameId != ""
+}
+
+func (g *BaseGame[PlayerT]) PreviousGameId() GameId {
+	return g.previousGameId
+}
+
+// HasRematch tells whether a rematch of the game has been created.
+func (g *BaseGame[PlayerT]) HasRematch() bool {
+	return g.rematchId != ""
+}
+
+func (g *BaseGame[PlayerT]) RematchId() GameId {
+	return g.rematchId
+}
+
+func (g *BaseGame[PlayerT]) SetRematchId(rematchId GameId) {
+	g.rematchId = rematchId
+}
+
+// History returns the results of the previous games of the table, oldest first.
+func (g *BaseGame[PlayerT]) History() []TableResults {
+	return g.history
+}
+
+// NbTableGame is the number of games played at the table, the current one included once stopped.
+func (g *BaseGame[PlayerT]) NbTableGame() int {
+	if g.IsStopped() {
+		return len(g.history) + 1
+	}
+	return len(g.history)
+}
+
+// NbTableWin is the number of games of the table won by the user of the player, the current one included once stopped.
+func (g *BaseGame[PlayerT]) NbTableWin(playerId PlayerId) int {
+	nbWin := 0
+	for _, results := range g.history {
+		if results[playerId.UserId()].IsWin() {
+			nbWin++
+		}
+	}
+	if player, found := g.Player(playerId); found && g.IsStopped() && player.Result().IsWin() {
+		nbWin++
+	}
+	return nbWin
+}
+
+// NextRematch returns the rematch setup of a stopped game: same users, order rotated by one & results carried over.
+func (g *BaseGame[PlayerT]) NextRematch() Rematch {
+
+	//
+	// rotate seats
+	//
+
+	seats := make([]UserId, 0, len(g.players))
+	for _, playerIds := range g.order {
+		for _, playerId := range playerIds {
+			seats = append(seats, playerId.UserId())
+		}
+	}
+	if len(seats) > 1 {
+		seats = append(seats[1:], seats[0])
+	}
+
+	//
+	// carry results over
+	//
+
+	results := make(TableResults, len(g.players))
+	for playerId, player := range g.players {
+		results[playerId.UserId()] = player.Result()
+	}
+	history := make([]TableResults, 0, len(g.history)+1)
+	history = append(history, g.history...)
+	history = append(history, results)
+
+	return Rematch{
+		PreviousGameId: g.id,
+		Seats:          seats,
+		History:        history,
+	}
+}
+
+// SetRematchOrder orders the players by their seats at the table, players new to the table coming last.
+// It returns false for a game that is not a rematch, whose order is left unchanged.
+func (g *BaseGame[PlayerT]) SetRematchOrder() bool {
+	if !g.IsRematch() {
+		return false
+	}
+	ids := make([]PlayerId, 0, len(g.players))
+	for _, userId := range g.seats {
+		if playerId := NewPlayerId(g.id, userId); g.HasPlayer(playerId) {
+			ids = append(ids, playerId)
+		}
+	}
+	newIds := make([]PlayerId, 0)
+	for playerId := range g.players {
+		if !list.Contains(ids, playerId) {
+			newIds = append(newIds, playerId)
+		}
+	}
+	sort.Slice(newIds, func(i, j int) bool { return newIds[i] < newIds[j] })
+	ids = append(ids, newIds...)
+	order := make([][]PlayerId, 0, len(ids))
+	for _, id := range ids {
+		order = append(order, []PlayerId{id})
+	}
+	g.SetOrder(order)
+	return true
+}
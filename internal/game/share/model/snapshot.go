@@ -0,0 +1,181 @@
+package model
+
+import (
+	"encoding/json"
+	"math/rand"
+	"time"
+)
+
+// //////////////////////////////////////////////////
+// game snapshot
+
+type GameSnapshot struct {
+	Id           GameId          `json:"id"`
+	Status       GameStatus      `json:"status"`
+	CreatedAt    time.Time       `json:"created-at"`
+	UpdatedAt    time.Time       `json:"updated-at"`
+	Version      int             `json:"version"`
+	Seed         int64           `json:"seed"`
+	NbDraw       int64           `json:"nb-draw"`
+	Options      GameOptions     `json:"options"`
+	Settings     json.RawMessage `json:"settings,omitempty"`
+	InviteCode   InviteCode      `json:"invite-code,omitempty"`
+	TurnTimeout  time.Duration   `json:"turn-timeout,omitempty"`
+	TurnDeadline time.Time       `json:"turn-deadline,omitempty"`
+	MinNbPlayer  int             `json:"min-nb-player"`
+	MaxNbPlayer  int             `json:"max-nb-player"`
+	Round        int             `json:"round"`
+	Order        [][]PlayerId    `json:"order"`
+	Ranks        [][]PlayerId    `json:"ranks"`
+	HostId       PlayerId        `json:"host-id,omitempty"`
+	Rematch      *Rematch        `json:"rematch,omitempty"`
+	RematchId    GameId          `json:"rematch-id,omitempty"`
+}
+
+func (g *BaseGame[PlayerT]) Snapshot() GameSnapshot {
+	snapshot := GameSnapshot{
+		Id:           g.id,
+		Status:       g.status,
+		CreatedAt:    g.createdAt,
+		UpdatedAt:    g.updatedAt,
+		Version:      g.version,
+		Seed:         g.seed,
+		NbDraw:       g.source.nbDraw,
+		Options:      g.options,
+		Settings:     g.settings,
+		InviteCode:   g.inviteCode,
+		TurnTimeout:  g.turnTimeout,
+		TurnDeadline: g.turnDeadline,
+		MinNbPlayer:  g.minNbPlayer,
+		MaxNbPlayer:  g.maxNbPlayer,
+		Round:        g.round,
+		Order:        g.order,
+		Ranks:        g.ranks,
+		HostId:       g.hostId,
+		RematchId:    g.rematchId,
+	}
+	if g.IsRematch() {
+		snapshot.Rematch = &Rematch{
+			PreviousGameId: g.previousGameId,
+			Seats:          g.seats,
+			History:        g.history,
+		}
+	}
+	return snapshot
+}
+
+func NewGameFromSnapshot[PlayerT Player](snapshot GameSnapshot, players []PlayerT) *BaseGame[PlayerT] {
+	source := newRandomSource(snapshot.Seed, snapshot.NbDraw)
+	g := &BaseGame[PlayerT]{
+		id:           snapshot.Id,
+		status:       snapshot.Status,
+		createdAt:    snapshot.CreatedAt,
+		updatedAt:    snapshot.UpdatedAt,
+		version:      snapshot.Version,
+		seed:         snapshot.Seed,
+		source:       source,
+		random:       rand.New(source),
+		options:      snapshot.Options,
+		settings:     snapshot.Settings,
+		inviteCode:   snapshot.InviteCode,
+		turnTimeout:  snapshot.TurnTimeout,
+		turnDeadline: snapshot.TurnDeadline,
+		minNbPlayer:  snapshot.MinNbPlayer,
+		maxNbPlayer:  snapshot.MaxNbPlayer,
+		players:      make(map[PlayerId]PlayerT, len(players)),
+		round:        snapshot.Round,
+		order:        snapshot.Order,
+		ranks:        snapshot.Ranks,
+		hostId:       snapshot.HostId,
+		rematchId:    snapshot.RematchId,
+	}
+	if snapshot.Rematch != nil {
+		g.previousGameId = snapshot.Rematch.PreviousGameId
+		g.seats = snapshot.Rematch.Seats
+		g.history = snapshot.Rematch.History
+	}
+	if g.order == nil {
+		g.order = make([][]PlayerId, 0)
+	}
+	if g.ranks == nil {
+		g.ranks = make([][]PlayerId, 0)
+	}
+	for _, player := range players {
+		g.AttachPlayer(player)
+	}
+	return g
+}
+
+// //////////////////////////////////////////////////
+// player snapshot
+
+type PlayerSnapshot struct {
+	User     UserSnapshot `json:"user"`
+	Id       PlayerId     `json:"id"`
+	Status   PlayerStatus `json:"status"`
+	Ready    bool         `json:"ready,omitempty"`
+	GameId   GameId       `json:"game-id"`
+	HasScore bool         `json:"has-score"`
+	Score    PlayerScore  `json:"score"`
+	Rank     PlayerRank   `json:"rank"`
+	Result   PlayerResult `json:"result"`
+}
+
+func (p *BasePlayer) Snapshot() PlayerSnapshot {
+	return PlayerSnapshot{
+		User:     NewUserSnapshot(p.user),
+		Id:       p.id,
+		Status:   p.status,
+		Ready:    p.ready,
+		GameId:   p.gameId,
+		HasScore: p.hasScore,
+		Score:    p.score,
+		Rank:     p.rank,
+		Result:   p.result,
+	}
+}
+
+func NewPlayerFromSnapshot(snapshot PlayerSnapshot) *BasePlayer {
+	return &BasePlayer{
+		user:     snapshot.User.User(),
+		id:       snapshot.Id,
+		status:   snapshot.Status,
+		ready:    snapshot.Ready,
+		gameId:   snapshot.GameId,
+		hasScore: snapshot.HasScore,
+		score:    snapshot.Score,
+		rank:     snapshot.Rank,
+		result:   snapshot.Result,
+	}
+}
+
+// //////////////////////////////////////////////////
+// user snapshot
+
+type UserSnapshot struct {
+	Id       UserId       `json:"id"`
+	Name     UserName     `json:"name"`
+	Avatar   UserAvatar   `json:"avatar"`
+	Language UserLanguage `json:"language"`
+	Bot      bool         `json:"bot,omitempty"`
+}
+
+func NewUserSnapshot(user User) UserSnapshot {
+	return UserSnapshot{
+		Id:       user.Id(),
+		Name:     user.Name(),
+		Avatar:   user.Avatar(),
+		Language: user.Language(),
+		Bot:      user.IsBot(),
+	}
+}
+
+func (s UserSnapshot) User() User {
+	return &user{
+		id:       s.Id,
+		name:     s.Name,
+		avatar:   s.Avatar,
+		language: s.Language,
+		bot:      s.Bot,
+	}
+}
@@ -19,6 +19,8 @@ type User interface {
 	Language() UserLanguage
 	SetLanguage(language UserLanguage)
 
+	IsBot() bool
+
 	SetCookie(cookie *Cookie)
 }
 
@@ -31,6 +33,17 @@ func NewUser(id UserId) User {
 	}
 }
 
+// NewBotUser creates a computer opponent: it never has a websocket connection.
+func NewBotUser(name UserName) User {
+	return &user{
+		id:       NewBotUserId(),
+		name:     name,
+		avatar:   1,
+		language: UserLanguage_Fr,
+		bot:      true,
+	}
+}
+
 func NewUserFromCookie(cookie *Cookie) User {
 	return &user{
 		id:       cookie.Id,
@@ -46,6 +59,7 @@ func NewUserFromUser(other User) User {
 		name:     other.Name(),
 		avatar:   other.Avatar(),
 		language: other.Language(),
+		bot:      other.IsBot(),
 	}
 }
 
@@ -54,6 +68,7 @@ type user struct {
 	name     UserName
 	avatar   UserAvatar
 	language UserLanguage
+	bot      bool
 }
 
 func (u *user) HasId() bool {
@@ -112,6 +127,10 @@ func (u *user) SetLanguage(language UserLanguage) {
 	u.language = language
 }
 
+func (u *user) IsBot() bool {
+	return u.bot
+}
+
 func (u *user) SetCookie(cookie *Cookie) {
 	if u.id != cookie.Id {
 		panic(ErrInvalidCookie)
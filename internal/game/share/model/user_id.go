@@ -9,12 +9,18 @@ import (
 type UserId string
 
 var userIdAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+var botUserIdPrefix = "BOT"
 var generateUserId = util.Must(nanoid.CustomASCII(userIdAlphabet, 6))
 
 func NewUserId() UserId {
 	return UserId(generateUserId())
 }
 
+// NewBotUserId creates a user id that cannot collide with the id of a human user.
+func NewBotUserId() UserId {
+	return UserId(botUserIdPrefix + generateUserId())
+}
+
 func (id UserId) Validate() error {
 	if id == "" {
 		return ErrMissingUserId
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// bot
+
+const (
+	DefaultBotDelay = 800 * time.Millisecond
+)
+
+// Bot is the agent playing on behalf of the bot players of a game.
+type Bot[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
+	// NextAction is called with the game lock held whenever a bot player is playing.
+	NextAction(game GameT, player PlayerT) (BotAction[PlayerT, GameT], error)
+}
+
+// BotAction is played and recorded exactly as the same action of a human player would be,
+// so that games with bots can be replayed from their events.
+type BotAction[PlayerT model.Player, GameT model.Game[PlayerT]] struct {
+	EventType model.EventType
+	Payload   any
+	PlayFn    func(game GameT, player PlayerT) (bool, error)
+}
+
+// SetBot sets the agent playing for bot players: without it, bots cannot be added to games.
+func (s *gameService[PlayerT, GameT]) SetBot(bot Bot[PlayerT, GameT]) {
+	s.bot = bot
+}
+
+// SetBotDelay sets how long bots wait before playing.
+// A negative delay disables automatic play: bots then only play through PlayBot.
+func (s *gameService[PlayerT, GameT]) SetBotDelay(delay time.Duration) {
+	s.botDelay = delay
+}
+
+// //////////////////////////////////////////////////
+// add bot
+
+// AddBot makes a bot join the game of the given host while the game is still waiting for players.
+func (s *gameService[PlayerT, GameT]) AddBot(player PlayerT) (GameT, error) {
+	if s.bot == nil {
+		return s.empty, model.ErrBotNotSupported
+	}
+	game, err := s.gameStore.Get(player.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+	if !game.HasPlayer(player.Id()) {
+		return s.empty, model.ErrPlayerNotInGame
+	}
+	if player.IsBot() {
+		return s.empty, model.ErrWrongPlayer
+	}
+	if !game.IsHost(player.Id()) {
+		return s.empty, model.ErrNotHost
+	}
+	if err := game.Status().CanJoin(); err != nil {
+		return s.empty, err
+	}
+
+	nbBot := len(game.FilterPlayers(func(player PlayerT) bool {
+		return player.IsBot()
+	}))
+	bot := model.NewBotUser(model.UserName(fmt.Sprintf("Bot %d", nbBot+1)))
+	game, err = s.JoinGame(game, bot)
+	if err != nil {
+		return s.empty, err
+	}
+
+	// bots have no websocket to be notified through
+	s.onGame(game)
+
+	return game, nil
+}
+
+// //////////////////////////////////////////////////
+// play bot
+
+// PlayBot plays a single action of a playing bot of the game.
+// It returns model.ErrNoPlayingBot when no bot has to play.
+func (s *gameService[PlayerT, GameT]) PlayBot(gameId model.GameId) (GameT, error) {
+	unlock := s.lockGame(gameId)
+	defer unlock()
+
+	game, err := s.gameStore.Get(gameId)
+	if err != nil {
+		return s.empty, err
+	}
+	game, played, err := s.playBot(game)
+	if err != nil {
+		return s.empty, err
+	}
+	if !played {
+		return s.empty, model.ErrNoPlayingBot
+	}
+	if !game.IsStopped() {
+		// stopped games are already broadcast
+		s.onGame(game)
+	}
+	return game, nil
+}
+
+// playBot is called with the game lock held.
+// A bot failing to decide or to play falls back to the default action of a timed out turn.
+func (s *gameService[PlayerT, GameT]) playBot(game GameT) (GameT, bool, error) {
+	if s.bot == nil || !game.IsStarted() {
+		return game, false, nil
+	}
+	player, found := s.playingBot(game)
+	if !found {
+		return game, false, nil
+	}
+
+	s.logger.Info(fmt.Sprintf("[bot] game %s :: player %s :: round %d >>> PLAY", game.Id(), player.Id(), game.Round()))
+
+	action, err := s.bot.NextAction(game, player)
+	stop := false
+	if err == nil {
+		stop, err = action.PlayFn(game, player)
+	}
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("[bot] player %s unable to play, fall back to default action", player.Id()), zap.Error(err))
+		game, err = s.timeoutTurn(game, player)
+		if err != nil {
+			return s.empty, false, err
+		}
+		s.onEvent(game, model.EventType_TurnTimeout, player.Id(), nil)
+		return game, true, nil
+	}
+
+	if stop {
+		game, err = s.stopGame(game)
+	} else {
+		game, err = s.SaveGame(game)
+	}
+	if err != nil {
+		return s.empty, false, err
+	}
+	s.onEvent(game, action.EventType, player.Id(), action.Payload)
+	return game, true, nil
+}
+
+// playingBot returns the first playing bot ( by id, so that bots play in a stable order ).
+func (s *gameService[PlayerT, GameT]) playingBot(game GameT) (PlayerT, bool) {
+	bots := game.FilterPlayers(func(player PlayerT) bool {
+		return player.IsBot() && player.Status().IsPlaying()
+	})
+	if len(bots) == 0 {
+		var empty PlayerT
+		return empty, false
+	}
+	sort.Slice(bots, func(i, j int) bool { return bots[i].Id() < bots[j].Id() })
+	return bots[0], true
+}
+
+// //////////////////////////////////////////////////
+// bot timer
+
+// updateBotTimer schedules the next bot action whenever a bot has to play.
+// It is called with the game lock held, right before the game is stored.
+func (s *gameService[PlayerT, GameT]) updateBotTimer(game GameT) {
+	if s.bot == nil || s.botDelay < 0 || !game.IsStarted() {
+		return
+	}
+	if _, found := s.playingBot(game); !found {
+		return
+	}
+
+	s.botsMutex.Lock()
+	defer s.botsMutex.Unlock()
+
+	if _, found := s.bots[game.Id()]; found {
+		// the pending bot action will see the latest game
+		return
+	}
+	gameId := game.Id()
+	s.bots[gameId] = s.clock.AfterFunc(s.botDelay, func() {
+		s.runBot(gameId)
+	})
+}
+
+func (s *gameService[PlayerT, GameT]) stopBotTimer(gameId model.GameId) {
+	s.botsMutex.Lock()
+	defer s.botsMutex.Unlock()
+
+	if timer, found := s.bots[gameId]; found {
+		timer.Stop()
+		delete(s.bots, gameId)
+	}
+}
+
+func (s *gameService[PlayerT, GameT]) runBot(gameId model.GameId) {
+
+	unlock := s.lockGame(gameId)
+	defer unlock()
+
+	// release the slot first: storing the game below schedules the next bot action
+	s.botsMutex.Lock()
+	delete(s.bots, gameId)
+	s.botsMutex.Unlock()
+
+	game, err := s.gameStore.Get(gameId)
+	if err != nil {
+		return
+	}
+	game, played, err := s.playBot(game)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("[bot] game %s unable to play", gameId), zap.Error(err))
+		return
+	}
+	if played && !game.IsStopped() {
+		// stopped games are already broadcast
+		s.onGame(game)
+	}
+}
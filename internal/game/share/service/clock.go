@@ -0,0 +1,36 @@
+package service
+
+import (
+	"time"
+)
+
+// //////////////////////////////////////////////////
+// clock
+
+// Clock is where the service reads the time and schedules its turn, bot & reconnect timers.
+type Clock interface {
+	Now() time.Time
+	AfterFunc(delay time.Duration, fn func()) ClockTimer
+}
+
+// ClockTimer is a function scheduled on a clock.
+type ClockTimer interface {
+	// Stop prevents the function from running and tells whether it was still pending.
+	Stop() bool
+}
+
+// SetClock replaces the system clock, typically to drive timers from tests.
+// It must be called before any game is created.
+func (s *gameService[PlayerT, GameT]) SetClock(clock Clock) {
+	s.clock = clock
+}
+
+type systemClock struct{}
+
+func (c systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (c systemClock) AfterFunc(delay time.Duration, fn func()) ClockTimer {
+	return time.AfterFunc(delay, fn)
+}
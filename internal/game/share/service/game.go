@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -19,25 +22,57 @@ type GameService[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
 	GetGame(gameId model.GameId) (GameT, error)
 	GetJoinableGames() []GameT
 	GetNonJoinableGames(userId model.UserId) []GameT
+	GetUserGame(userId model.UserId) (GameT, error)
+	GetRunningGames(userId model.UserId) []GameT
 	SortGamesByCreationTime(games []GameT) []GameT
 	FilterGamesByPlayer(games []GameT, playerId model.PlayerId) []GameT
 
-	CreateGame(user model.User) (GameT, error)
+	CreateGame(user model.User, options model.GameOptions) (GameT, error)
+	CreateGameWithSettings(user model.User, options model.GameOptions, settings any) (GameT, error)
 	JoinGameId(gameId model.GameId, user model.User) (GameT, error)
+	JoinInviteCode(code model.InviteCode, user model.User) (GameT, error)
 	JoinGame(game GameT, user model.User) (GameT, error)
+	UpdatePlayerSettings(player PlayerT, options model.GameOptions) (GameT, error)
+	UpdateSettings(game GameT, player PlayerT, options model.GameOptions) (GameT, error)
+	KickPlayer(host PlayerT, playerId model.PlayerId) (GameT, error)
+	SetPlayerReady(player PlayerT, ready bool) (GameT, error)
 	StartPlayerGame(player PlayerT) (GameT, error)
 	StartGame(game GameT) (GameT, error)
 	LeavePlayerGame(player PlayerT) (GameT, error)
 	LeaveGame(game GameT, player PlayerT) (GameT, error)
+	RematchPlayerGame(player PlayerT) (GameT, error)
+	RematchGame(game GameT, player PlayerT) (GameT, error)
 	StopGame(game GameT) (GameT, error)
 	DeleteGameId(gameId model.GameId, playerId model.PlayerId) error
 	DeleteGame(game GameT, playerId model.PlayerId) error
 
+	Play(player PlayerT, version int, eventType model.EventType, payload any, playFn func(game GameT, player PlayerT) (bool, error)) (GameT, error)
 	SaveGame(game GameT) (GameT, error)
 
 	RegisterOnJoinGame(func(game GameT, player PlayerT))
 	RegisterOnGame(func(game GameT))
+	RegisterOnEvent(func(event model.Event))
 	RegisterOnLeaveGame(func(game GameT, userId model.UserId))
+	RegisterOnKickPlayer(func(game GameT, userId model.UserId))
+	RegisterOnTurnTick(func(game GameT, remaining time.Duration))
+	RegisterGameActivity(func(game GameT) time.Time)
+	RegisterIsAtTable(func(game GameT, player PlayerT) bool)
+
+	SetReconnectGrace(grace time.Duration)
+	DisconnectPlayer(playerId model.PlayerId) (GameT, error)
+	ReconnectPlayer(playerId model.PlayerId) (GameT, error)
+
+	SetBot(bot Bot[PlayerT, GameT])
+	SetBotDelay(delay time.Duration)
+	AddBot(player PlayerT) (GameT, error)
+	PlayBot(gameId model.GameId) (GameT, error)
+
+	StartJanitor(ctx context.Context, config JanitorConfig)
+	ExpireGames(ttls map[model.GameStatus]time.Duration, now time.Time) int
+
+	ResumeGames()
+
+	SetClock(clock Clock)
 }
 
 // //////////////////////////////////////////////////
@@ -45,7 +80,7 @@ type GameService[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
 
 type GamePlugin[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
 	CanCreateGame(user model.User) error
-	CreateGame(user model.User) (GameT, PlayerT, error)
+	CreateGame(user model.User, setup model.GameSetup) (GameT, PlayerT, error)
 
 	CanJoinGame(game GameT, user model.User) error
 	JoinGame(game GameT, user model.User) (GameT, PlayerT, error)
@@ -60,24 +95,57 @@ type GamePlugin[PlayerT model.Player, GameT model.Game[PlayerT]] interface {
 	LeaveGame(game GameT, player PlayerT) (GameT, error)
 
 	CanDeleteGame(game GameT, playerId model.PlayerId) error
+
+	// PlayDefault plays on behalf of a player whose turn timed out and tells whether the game should stop.
+	// It returns model.ErrNoDefaultAction when the player should rather forfeit.
+	// It must check the move before changing the game: a failing default move leaves the game untouched.
+	PlayDefault(game GameT, player PlayerT) (bool, error)
 }
 
 func NewGameService[PlayerT model.Player, GameT model.Game[PlayerT]](logger *zap.Logger, plugin GamePlugin[PlayerT, GameT], gameStore store.GameStore[GameT]) GameService[PlayerT, GameT] {
+	return newGameService(logger, plugin, gameStore)
+}
+
+func newGameService[PlayerT model.Player, GameT model.Game[PlayerT]](logger *zap.Logger, plugin GamePlugin[PlayerT, GameT], gameStore store.GameStore[GameT]) *gameService[PlayerT, GameT] {
 	return &gameService[PlayerT, GameT]{
-		logger:    logger,
-		plugin:    plugin,
-		gameStore: gameStore,
+		logger:     logger,
+		plugin:     plugin,
+		gameStore:  gameStore,
+		locks:      make(map[model.GameId]*gameLock),
+		turns:      make(map[model.GameId]*turnTimer),
+		reconnects: make(map[model.PlayerId]ClockTimer),
+		botDelay:   DefaultBotDelay,
+		bots:       make(map[model.GameId]ClockTimer),
+		clock:      systemClock{},
 	}
 }
 
 type gameService[PlayerT model.Player, GameT model.Game[PlayerT]] struct {
-	logger     *zap.Logger
-	plugin     GamePlugin[PlayerT, GameT]
-	gameStore  store.GameStore[GameT]
-	onJoinFns  []func(game GameT, player PlayerT)
-	onGameFns  []func(game GameT)
-	onLeaveFns []func(game GameT, userId model.UserId)
-	empty      GameT
+	logger          *zap.Logger
+	plugin          GamePlugin[PlayerT, GameT]
+	gameStore       store.GameStore[GameT]
+	onJoinFns       []func(game GameT, player PlayerT)
+	onGameFns       []func(game GameT)
+	onEventFns      []func(event model.Event)
+	onLeaveFns      []func(game GameT, userId model.UserId)
+	onKickFns       []func(game GameT, userId model.UserId)
+	onTurnFns       []func(game GameT, remaining time.Duration)
+	activityFns     []func(game GameT) time.Time
+	isAtTableFns    []func(game GameT, player PlayerT) bool
+	locksMutex      sync.Mutex
+	locks           map[model.GameId]*gameLock
+	turnsMutex      sync.Mutex
+	turns           map[model.GameId]*turnTimer
+	noTurnTimer     bool
+	reconnectGrace  time.Duration
+	reconnectsMutex sync.Mutex
+	reconnects      map[model.PlayerId]ClockTimer
+	bot             Bot[PlayerT, GameT]
+	botDelay        time.Duration
+	botsMutex       sync.Mutex
+	bots            map[model.GameId]ClockTimer
+	clock           Clock
+	empty           GameT
 }
 
 // //////////////////////////////////////////////////
@@ -107,11 +175,22 @@ func (s *gameService[PlayerT, GameT]) GetGame(id model.GameId) (GameT, error) {
 // //////////////////////////////////////////////////
 // get joinable games
 
+// GetJoinableGames returns the public games waiting for players ( private games are only joined through their invite code ).
 func (s *gameService[PlayerT, GameT]) GetJoinableGames() []GameT {
+	games := make([]GameT, 0)
+	for _, game := range s.listJoinableGames() {
+		if !game.IsPrivate() {
+			games = append(games, game)
+		}
+	}
+	return s.SortGamesByCreationTime(games)
+}
+
+func (s *gameService[PlayerT, GameT]) listJoinableGames() []GameT {
 	games := make([]GameT, 0)
 	games = append(games, s.gameStore.ListStatus(model.GameStatus_JoinableNotStartable)...)
 	games = append(games, s.gameStore.ListStatus(model.GameStatus_JoinableAndStartable)...)
-	return s.SortGamesByCreationTime(games)
+	return games
 }
 
 // //////////////////////////////////////////////////
@@ -126,6 +205,38 @@ func (s *gameService[PlayerT, GameT]) GetNonJoinableGames(userId model.UserId) [
 	return s.SortGamesByCreationTime(games)
 }
 
+// //////////////////////////////////////////////////
+// get running games
+
+// GetRunningGames returns the started public games the user is not part of ( i.e. the ones they can spectate ).
+func (s *gameService[PlayerT, GameT]) GetRunningGames(userId model.UserId) []GameT {
+	games := s.gameStore.ListStatus(model.GameStatus_Started)
+	filtered := make([]GameT, 0, len(games))
+	for _, game := range games {
+		if !game.HasUser(userId) && !game.IsPrivate() {
+			filtered = append(filtered, game)
+		}
+	}
+	return s.SortGamesByCreationTime(filtered)
+}
+
+// //////////////////////////////////////////////////
+// get user game
+
+// GetUserGame returns the most recent game the user is still part of ( i.e. not stopped ).
+func (s *gameService[PlayerT, GameT]) GetUserGame(userId model.UserId) (GameT, error) {
+	games := make([]GameT, 0)
+	games = append(games, s.gameStore.ListStatus(model.GameStatus_JoinableNotStartable)...)
+	games = append(games, s.gameStore.ListStatus(model.GameStatus_JoinableAndStartable)...)
+	games = append(games, s.gameStore.ListStatus(model.GameStatus_NotJoinableAndStartable)...)
+	games = append(games, s.gameStore.ListStatus(model.GameStatus_Started)...)
+	games = s.SortGamesByCreationTime(s.FilterGamesByUser(games, userId))
+	if len(games) == 0 {
+		return s.empty, model.ErrGameNotFound
+	}
+	return games[0], nil
+}
+
 func (s *gameService[PlayerT, GameT]) SortGamesByCreationTime(games []GameT) []GameT {
 	sort.Slice(games, func(i, j int) bool {
 		// sort by reverse creation time
@@ -157,7 +268,21 @@ func (s *gameService[PlayerT, GameT]) FilterGamesByUser(games []GameT, userId mo
 // //////////////////////////////////////////////////
 // create game
 
-func (s *gameService[PlayerT, GameT]) CreateGame(user model.User) (GameT, error) {
+func (s *gameService[PlayerT, GameT]) CreateGame(user model.User, options model.GameOptions) (GameT, error) {
+	return s.CreateGameWithSettings(user, options, nil)
+}
+
+// CreateGameWithSettings creates a game with settings specific to the game, decoded by its plugin from the game setup.
+func (s *gameService[PlayerT, GameT]) CreateGameWithSettings(user model.User, options model.GameOptions, settings any) (GameT, error) {
+	setup := model.NewGameSetup(options)
+	var err error
+	if setup.Settings, err = model.EncodeSettings(settings); err != nil {
+		return s.empty, err
+	}
+	return s.createGame(user, setup)
+}
+
+func (s *gameService[PlayerT, GameT]) createGame(user model.User, setup model.GameSetup) (GameT, error) {
 
 	var game GameT
 	var player PlayerT
@@ -180,13 +305,17 @@ func (s *gameService[PlayerT, GameT]) CreateGame(user model.User) (GameT, error)
 	// join game
 	//
 
-	game, player, err = s.plugin.CreateGame(user)
+	game, player, err = s.plugin.CreateGame(user, setup)
 	if err != nil {
 		return s.empty, err
 	}
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
 	if !game.HasPlayer(player.Id()) {
 		game.AttachPlayer(player)
 	}
+	game.SetHostId(player.Id())
 	game.UpdateJoinStatus()
 
 	//
@@ -202,6 +331,10 @@ func (s *gameService[PlayerT, GameT]) CreateGame(user model.User) (GameT, error)
 	// callbacks
 	//
 
+	s.onEvent(game, model.EventType_CreateGame, player.Id(), model.CreateGamePayload{
+		User:  model.NewUserSnapshot(user),
+		Setup: setup,
+	})
 	s.onJoinGame(game, player)
 
 	return game, nil
@@ -215,16 +348,50 @@ func (s *gameService[PlayerT, GameT]) JoinGameId(id model.GameId, user model.Use
 	if err != nil {
 		return s.empty, err
 	}
+	if game.IsPrivate() && !game.HasUser(user.Id()) {
+		return s.empty, model.ErrPrivateGame
+	}
 	return s.JoinGame(game, user)
 }
 
+func (s *gameService[PlayerT, GameT]) JoinInviteCode(code model.InviteCode, user model.User) (GameT, error) {
+	if code == "" {
+		return s.empty, model.ErrMissingInviteCode
+	}
+	if game, err := s.GetUserGame(user.Id()); err == nil && game.InviteCode() != code {
+		return s.empty, model.ErrAlreadyInGame
+	}
+	for _, game := range s.listJoinableGames() {
+		if game.InviteCode() == code {
+			return s.JoinGame(game, user)
+		}
+	}
+	return s.empty, model.ErrInvalidInviteCode
+}
+
 func (s *gameService[PlayerT, GameT]) JoinGame(game GameT, user model.User) (GameT, error) {
 
 	var player PlayerT
 	var err error
+	gameId := game.Id()
+	joined := false
+
+	unlock := s.lockGame(gameId)
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err = s.gameStore.Get(gameId)
+	if err != nil {
+		return s.empty, err
+	}
 
 	s.logger.Info(fmt.Sprintf("[DEBUG] >>> join-game :: game %s %s :: user %s", game.Id(), game.Status().String(), user.Id()))
 	defer func() {
+		if !joined {
+			// neither the game nor the player can be relied on
+			s.logger.Info(fmt.Sprintf("[DEBUG] <<< join-game :: game %s :: user %s", gameId, user.Id()))
+			return
+		}
 		s.logger.Info(fmt.Sprintf("[DEBUG] <<< join-game :: game %s %s :: player %s %s", game.Id(), game.Status().String(), player.Id(), player.Status().String()))
 	}()
 
@@ -273,11 +440,15 @@ func (s *gameService[PlayerT, GameT]) JoinGame(game GameT, user model.User) (Gam
 	if err != nil {
 		return s.empty, err
 	}
+	joined = true
 
 	//
 	// callbacks
 	//
 
+	s.onEvent(game, model.EventType_JoinGame, player.Id(), model.JoinGamePayload{
+		User: model.NewUserSnapshot(user),
+	})
 	s.onJoinGame(game, player)
 
 	return game, nil
@@ -286,16 +457,29 @@ func (s *gameService[PlayerT, GameT]) JoinGame(game GameT, user model.User) (Gam
 // //////////////////////////////////////////////////
 // start game
 
+// StartPlayerGame starts the game on behalf of one of its players: only the host can.
 func (s *gameService[PlayerT, GameT]) StartPlayerGame(player PlayerT) (GameT, error) {
 	game, err := s.gameStore.Get(player.GameId())
 	if err != nil {
 		return s.empty, err
 	}
+	if !game.IsHost(player.Id()) {
+		return s.empty, model.ErrNotHost
+	}
 	return s.StartGame(game)
 }
 
 func (s *gameService[PlayerT, GameT]) StartGame(game GameT) (GameT, error) {
 
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return s.empty, err
+	}
+
 	s.logger.Info(fmt.Sprintf("[DEBUG] >>> start-game :: game %s %s", game.Id(), game.Status().String()))
 	defer func() {
 		s.logger.Info(fmt.Sprintf("[DEBUG] <<< start-game :: game %s %s", game.Id(), game.Status().String()))
@@ -311,12 +495,15 @@ func (s *gameService[PlayerT, GameT]) StartGame(game GameT) (GameT, error) {
 	if err := s.plugin.CanStartGame(game); err != nil {
 		return s.empty, err
 	}
+	if game.HasReadyCheck() && !game.IsEveryoneReady() {
+		return s.empty, model.ErrPlayersNotReady
+	}
 
 	//
 	// start game
 	//
 
-	game, err := s.plugin.StartGame(game)
+	game, err = s.plugin.StartGame(game)
 	if err != nil {
 		return s.empty, err
 	}
@@ -337,6 +524,7 @@ func (s *gameService[PlayerT, GameT]) StartGame(game GameT) (GameT, error) {
 	// callbacks
 	//
 
+	s.onEvent(game, model.EventType_StartGame, "", nil)
 	s.onGame(game)
 
 	return game, nil
@@ -354,6 +542,18 @@ func (s *gameService[PlayerT, GameT]) LeavePlayerGame(player PlayerT) (GameT, er
 }
 
 func (s *gameService[PlayerT, GameT]) LeaveGame(game GameT, player PlayerT) (GameT, error) {
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return s.empty, err
+	}
+	return s.leaveGame(game, player)
+}
+
+func (s *gameService[PlayerT, GameT]) leaveGame(game GameT, player PlayerT) (GameT, error) {
 
 	s.logger.Info(fmt.Sprintf("[DEBUG] >>> leave-game :: game %s %s :: player %s %s", game.Id(), game.Status().String(), player.Id(), player.Status().String()))
 	defer func() {
@@ -372,7 +572,8 @@ func (s *gameService[PlayerT, GameT]) LeaveGame(game GameT, player PlayerT) (Gam
 	// check player
 	//
 
-	if ok := game.HasPlayer(player.Id()); !ok {
+	player, found := game.Player(player.Id())
+	if !found {
 		return s.empty, model.ErrPlayerNotInGame
 	}
 
@@ -392,6 +593,10 @@ func (s *gameService[PlayerT, GameT]) LeaveGame(game GameT, player PlayerT) (Gam
 	if err != nil {
 		return s.empty, err
 	}
+	if !game.WasStarted() && game.HasPlayers() && !game.HasHumanPlayers() {
+		// nobody is left to start a game between bots
+		game.MarkForDeletion()
+	}
 	game.UpdateJoinStatus()
 
 	//
@@ -414,6 +619,7 @@ func (s *gameService[PlayerT, GameT]) LeaveGame(game GameT, player PlayerT) (Gam
 	// callbacks
 	//
 
+	s.onEvent(game, model.EventType_LeaveGame, player.Id(), nil)
 	userId := player.Id().UserId()
 	s.onLeaveGame(game, userId)
 
@@ -424,6 +630,23 @@ func (s *gameService[PlayerT, GameT]) LeaveGame(game GameT, player PlayerT) (Gam
 // stop game
 
 func (s *gameService[PlayerT, GameT]) StopGame(game GameT) (GameT, error) {
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return s.empty, err
+	}
+	game, err = s.stopGame(game)
+	if err != nil {
+		return s.empty, err
+	}
+	s.onEvent(game, model.EventType_StopGame, "", nil)
+	return game, nil
+}
+
+func (s *gameService[PlayerT, GameT]) stopGame(game GameT) (GameT, error) {
 
 	s.logger.Info(fmt.Sprintf("[DEBUG] >>> stop-game :: game %s %s", game.Id(), game.Status().String()))
 	defer func() {
@@ -494,6 +717,15 @@ func (s *gameService[PlayerT, GameT]) DeleteGameId(id model.GameId, playerId mod
 
 func (s *gameService[PlayerT, GameT]) DeleteGame(game GameT, playerId model.PlayerId) error {
 
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return err
+	}
+
 	s.logger.Info(fmt.Sprintf("[DEBUG] >>> delete-game :: game %s %s :: player %s", game.Id(), game.Status().String(), playerId))
 	defer func() {
 		s.logger.Info(fmt.Sprintf("[DEBUG] <<< delete-game :: game %s %s :: player %s", game.Id(), game.Status().String(), playerId))
@@ -530,6 +762,103 @@ func (s *gameService[PlayerT, GameT]) DeleteGame(game GameT, playerId model.Play
 	return s.deleteGame(game)
 }
 
+// //////////////////////////////////////////////////
+// play
+
+// Play applies playFn to the game of the playing player while holding the game lock.
+// The game is stopped when playFn returns true, otherwise it is saved.
+// The version is the one the player has seen: the action is rejected if it is missing or if the game moved on since.
+// Once applied, the action is recorded as an event of the given type with the given payload.
+func (s *gameService[PlayerT, GameT]) Play(player PlayerT, version int, eventType model.EventType, payload any, playFn func(game GameT, player PlayerT) (bool, error)) (GameT, error) {
+
+	unlock := s.lockGame(player.GameId())
+	defer unlock()
+
+	//
+	// check status
+	//
+
+	game, err := s.gameStore.Get(player.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+	if err := game.Status().CanPlay(); err != nil {
+		return s.empty, err
+	}
+	// stored games start at version 1, so a missing version is always stale
+	if version != game.Version() {
+		return s.empty, model.ErrStaleGame
+	}
+
+	return s.play(game, player, eventType, payload, playFn)
+}
+
+// play is called with the game lock held, once the status of the game has been checked.
+func (s *gameService[PlayerT, GameT]) play(game GameT, player PlayerT, eventType model.EventType, payload any, playFn func(game GameT, player PlayerT) (bool, error)) (GameT, error) {
+
+	//
+	// check player
+	//
+
+	player, found := game.Player(player.Id())
+	if !found {
+		return s.empty, model.ErrPlayerNotInGame
+	}
+	if !player.Status().IsPlaying() {
+		return s.empty, model.ErrWrongPlayer
+	}
+
+	//
+	// play
+	//
+
+	stop, err := playFn(game, player)
+	if err != nil {
+		return s.empty, err
+	}
+	if stop {
+		game, err = s.stopGame(game)
+	} else {
+		game, err = s.SaveGame(game)
+	}
+	if err != nil {
+		return s.empty, err
+	}
+	s.onEvent(game, eventType, player.Id(), payload)
+	return game, nil
+}
+
+// //////////////////////////////////////////////////
+// lock game
+
+type gameLock struct {
+	sync.Mutex
+	nbRef int
+}
+
+// lockGame serializes every mutation of a given game and returns the unlock function.
+func (s *gameService[PlayerT, GameT]) lockGame(id model.GameId) func() {
+	s.locksMutex.Lock()
+	lock, found := s.locks[id]
+	if !found {
+		lock = &gameLock{}
+		s.locks[id] = lock
+	}
+	lock.nbRef++
+	s.locksMutex.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		s.locksMutex.Lock()
+		lock.nbRef--
+		if lock.nbRef == 0 {
+			delete(s.locks, id)
+		}
+		s.locksMutex.Unlock()
+	}
+}
+
 // //////////////////////////////////////////////////
 // save game
 
@@ -541,6 +870,9 @@ func (s *gameService[PlayerT, GameT]) SaveGame(game GameT) (GameT, error) {
 }
 
 func (s *gameService[PlayerT, GameT]) storeGame(game GameT) (GameT, error) {
+	s.updateTurnTimer(game)
+	s.updateBotTimer(game)
+	game.SetUpdatedAt(s.clock.Now())
 	if err := s.gameStore.Set(game); err != nil {
 		return s.empty, err
 	}
@@ -548,6 +880,8 @@ func (s *gameService[PlayerT, GameT]) storeGame(game GameT) (GameT, error) {
 }
 
 func (s *gameService[PlayerT, GameT]) deleteGame(game GameT) error {
+	s.stopTurnTimer(game.Id())
+	s.stopBotTimer(game.Id())
 	return s.gameStore.Delete(game.Id())
 }
 
@@ -574,6 +908,25 @@ func (s *gameService[PlayerT, GameT]) onGame(game GameT) {
 	}
 }
 
+func (s *gameService[PlayerT, GameT]) RegisterOnEvent(onEventFn func(event model.Event)) {
+	s.onEventFns = append(s.onEventFns, onEventFn)
+}
+
+func (s *gameService[PlayerT, GameT]) onEvent(game GameT, eventType model.EventType, playerId model.PlayerId, payload any) {
+	if len(s.onEventFns) == 0 {
+		return
+	}
+	event, err := model.NewEvent(game.Id(), eventType, playerId, payload)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("[event] unable to record %s event of game %s", eventType, game.Id()), zap.Error(err))
+		return
+	}
+	event.Version = game.Version()
+	for _, onEventFn := range s.onEventFns {
+		onEventFn(event)
+	}
+}
+
 func (s *gameService[PlayerT, GameT]) RegisterOnLeaveGame(onLeaveFn func(game GameT, userId model.UserId)) {
 	s.onLeaveFns = append(s.onLeaveFns, onLeaveFn)
 }
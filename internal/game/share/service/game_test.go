@@ -0,0 +1,470 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+	"github.com/gre-ory/games-go/internal/game/share/store"
+)
+
+// //////////////////////////////////////////////////
+// test game
+
+const (
+	testNbMove = 6
+
+	testEventType_Play model.EventType = "test-play"
+)
+
+// testGame is played by two players taking turns: the player making the last move wins.
+type testGame struct {
+	*model.BaseGame[*model.BasePlayer]
+	Moves []model.PlayerId
+}
+
+func newTestGame(setup model.GameSetup, maxNbPlayer int) *testGame {
+	return &testGame{
+		BaseGame: model.NewGame[*model.BasePlayer](setup, 2, maxNbPlayer),
+	}
+}
+
+func playTest(game *testGame, player *model.BasePlayer) (bool, error) {
+	game.Moves = append(game.Moves, player.Id())
+	if len(game.Moves) == testNbMove {
+		game.SetWinners(player.Id())
+		return true, nil
+	}
+	game.NextRound()
+	game.SetPlayingRoundPlayer()
+	return false, nil
+}
+
+func replayTest(event model.Event) (func(game *testGame, player *model.BasePlayer) (bool, error), error) {
+	if event.Type != testEventType_Play {
+		return nil, model.ErrUnknownEvent
+	}
+	return playTest, nil
+}
+
+// //////////////////////////////////////////////////
+// test plugin
+
+type testPlugin struct {
+	// playDefaultFn replaces the default move, which plays as the player would.
+	playDefaultFn func(game *testGame, player *model.BasePlayer) (bool, error)
+	// canStopErr prevents games from being stopped.
+	canStopErr error
+	// maxNbPlayer lets more than two players join a game.
+	maxNbPlayer int
+}
+
+func (p *testPlugin) CanCreateGame(user model.User) error {
+	return nil
+}
+
+func (p *testPlugin) CreateGame(user model.User, setup model.GameSetup) (*testGame, *model.BasePlayer, error) {
+	game := newTestGame(setup, max(2, p.maxNbPlayer))
+	return game, model.NewPlayerFromUser(game.Id(), user), nil
+}
+
+func (p *testPlugin) CanJoinGame(game *testGame, user model.User) error {
+	return nil
+}
+
+func (p *testPlugin) JoinGame(game *testGame, user model.User) (*testGame, *model.BasePlayer, error) {
+	return game, model.NewPlayerFromUser(game.Id(), user), nil
+}
+
+func (p *testPlugin) CanStartGame(game *testGame) error {
+	if !game.CanStart() {
+		return model.ErrMissingPlayers
+	}
+	return nil
+}
+
+func (p *testPlugin) StartGame(game *testGame) (*testGame, error) {
+	if !game.SetRematchOrder() {
+		game.SetRandomOrder()
+	}
+	game.FirstRound()
+	game.SetPlayingRoundPlayer()
+	return game, nil
+}
+
+func (p *testPlugin) CanStopGame(game *testGame) error {
+	return p.canStopErr
+}
+
+func (p *testPlugin) StopGame(game *testGame) (*testGame, error) {
+	return game, nil
+}
+
+func (p *testPlugin) CanLeaveGame(game *testGame, player *model.BasePlayer) error {
+	return nil
+}
+
+func (p *testPlugin) LeaveGame(game *testGame, player *model.BasePlayer) (*testGame, error) {
+	switch {
+	case game.IsStopped():
+	case game.IsStarted():
+		game.SetLoosers(player.Id())
+		game.SetStopped()
+	default:
+		game.DetachPlayer(player)
+		if !game.HasPlayers() {
+			game.MarkForDeletion()
+		}
+	}
+	return game, nil
+}
+
+func (p *testPlugin) CanDeleteGame(game *testGame, playerId model.PlayerId) error {
+	return nil
+}
+
+func (p *testPlugin) PlayDefault(game *testGame, player *model.BasePlayer) (bool, error) {
+	if p.playDefaultFn != nil {
+		return p.playDefaultFn(game, player)
+	}
+	return playTest(game, player)
+}
+
+// //////////////////////////////////////////////////
+// test bot
+
+type testBot struct{}
+
+func (b *testBot) NextAction(game *testGame, player *model.BasePlayer) (BotAction[*model.BasePlayer, *testGame], error) {
+	return BotAction[*model.BasePlayer, *testGame]{
+		EventType: testEventType_Play,
+		PlayFn:    playTest,
+	}, nil
+}
+
+// //////////////////////////////////////////////////
+// test clock
+
+// testClock only moves forward when advanced, running the timers that are due on the calling goroutine.
+type testClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*testTimer
+}
+
+type testTimer struct {
+	clock *testClock
+	at    time.Time
+	fn    func()
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *testClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *testClock) AfterFunc(delay time.Duration, fn func()) ClockTimer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	timer := &testTimer{clock: c, at: c.now.Add(delay), fn: fn}
+	c.timers = append(c.timers, timer)
+	return timer
+}
+
+func (t *testTimer) Stop() bool {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+	for i, timer := range t.clock.timers {
+		if timer == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Advance moves the clock forward, running the due timers in order, including the ones they schedule.
+func (c *testClock) Advance(delay time.Duration) {
+	c.mutex.Lock()
+	end := c.now.Add(delay)
+	for {
+		next := -1
+		for i, timer := range c.timers {
+			if !timer.at.After(end) && (next < 0 || timer.at.Before(c.timers[next].at)) {
+				next = i
+			}
+		}
+		if next < 0 {
+			break
+		}
+		timer := c.timers[next]
+		c.timers = append(c.timers[:next], c.timers[next+1:]...)
+		c.now = timer.at
+		c.mutex.Unlock()
+		timer.fn()
+		c.mutex.Lock()
+	}
+	c.now = end
+	c.mutex.Unlock()
+}
+
+// //////////////////////////////////////////////////
+// test helpers
+
+func newTestService(plugin *testPlugin) *gameService[*model.BasePlayer, *testGame] {
+	return newGameService[*model.BasePlayer, *testGame](zap.NewNop(), plugin, store.NewGameMemoryStore[*testGame]())
+}
+
+func newTestUser(index int) model.User {
+	return model.NewUser(model.UserId(fmt.Sprintf("U%d", index)))
+}
+
+func newTestPlayer(gameId model.GameId, user model.User) *model.BasePlayer {
+	return model.NewPlayerFromUser(gameId, user)
+}
+
+// newStartedTestGame creates & starts a game between the first two test users.
+func newStartedTestGame(t *testing.T, s *gameService[*model.BasePlayer, *testGame], options model.GameOptions) *testGame {
+	game, err := s.CreateGame(newTestUser(1), options)
+	require.NoError(t, err)
+	_, err = s.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+	game, err = s.StartGame(game)
+	require.NoError(t, err)
+	return game
+}
+
+// playTestMove plays the move of a player as a client would, with the version of the game they have seen.
+func playTestMove(s *gameService[*model.BasePlayer, *testGame], player *model.BasePlayer, version int) (*testGame, error) {
+	return s.Play(player, version, testEventType_Play, nil, playTest)
+}
+
+// //////////////////////////////////////////////////
+// tests
+
+func TestConcurrentJoinLeaveGame(t *testing.T) {
+
+	const (
+		nbUser = 20
+		nbLoop = 50
+	)
+
+	service := newTestService(&testPlugin{})
+	owner := newTestUser(0)
+	game, err := service.CreateGame(owner, model.GameOptions{})
+	require.NoError(t, err)
+	gameId := game.Id()
+
+	var wg sync.WaitGroup
+	for index := 1; index <= nbUser; index++ {
+		user := newTestUser(index)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for loop := 0; loop < nbLoop; loop++ {
+				_, err := service.JoinGameId(gameId, user)
+				if errors.Is(err, model.ErrGameNotJoinable) {
+					continue
+				}
+				if !assert.NoError(t, err) {
+					return
+				}
+				_, err = service.LeavePlayerGame(newTestPlayer(gameId, user))
+				if !assert.NoError(t, err) {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	game, err = service.GetGame(gameId)
+	require.NoError(t, err)
+	require.Equal(t, 1, game.NbPlayer())
+	require.True(t, game.HasUser(owner.Id()))
+	require.Equal(t, model.GameStatus_JoinableNotStartable, game.Status())
+}
+
+func TestConcurrentPlayGame(t *testing.T) {
+
+	const (
+		nbGame = 20
+	)
+
+	service := newTestService(&testPlugin{})
+
+	// players play the last recorded version, as a client renders the last broadcast board
+	var versionsMutex sync.Mutex
+	versions := map[model.GameId]int{}
+	service.RegisterOnEvent(func(event model.Event) {
+		versionsMutex.Lock()
+		defer versionsMutex.Unlock()
+		versions[event.GameId] = event.Version
+	})
+	lastVersion := func(gameId model.GameId) int {
+		versionsMutex.Lock()
+		defer versionsMutex.Unlock()
+		return versions[gameId]
+	}
+
+	gameIds := make([]model.GameId, 0, nbGame)
+	for index := 0; index < nbGame; index++ {
+		game := newStartedTestGame(t, service, model.GameOptions{})
+		gameIds = append(gameIds, game.Id())
+	}
+
+	var wg sync.WaitGroup
+	for _, gameId := range gameIds {
+		for _, user := range []model.User{newTestUser(1), newTestUser(2)} {
+			player := newTestPlayer(gameId, user)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for stopped := false; !stopped; {
+					_, err := playTestMove(service, player, lastVersion(gameId))
+					switch {
+					case err == nil:
+					case errors.Is(err, model.ErrStaleGame):
+					case errors.Is(err, model.ErrWrongPlayer):
+					case errors.Is(err, model.ErrGameStopped):
+						stopped = true
+					default:
+						assert.NoError(t, err)
+						return
+					}
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	for _, gameId := range gameIds {
+		game, err := service.GetGame(gameId)
+		require.NoError(t, err)
+		require.True(t, game.IsStopped())
+		require.Len(t, game.Moves, testNbMove)
+		require.Equal(t, testNbMove, game.Round(), "each accepted move must be applied exactly once")
+		for index, playerId := range game.Moves {
+			require.Equal(t, game.OrderedPlayerId(index+1), playerId, "players must alternate")
+		}
+	}
+}
+
+func TestStaleVersion(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	game := newStartedTestGame(t, service, model.GameOptions{})
+	version := game.Version()
+
+	game, err := playTestMove(service, game.RoundPlayer(), version)
+	require.NoError(t, err)
+	require.Equal(t, version+1, game.Version())
+
+	// replayed action
+	_, err = playTestMove(service, game.RoundPlayer(), version)
+	require.ErrorIs(t, err, model.ErrStaleGame)
+
+	// missing version
+	_, err = playTestMove(service, game.RoundPlayer(), 0)
+	require.ErrorIs(t, err, model.ErrStaleGame)
+
+	_, err = playTestMove(service, game.RoundPlayer(), version+1)
+	require.NoError(t, err)
+}
+
+func TestGetRunningGames(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	user1, user2, spectator := newTestUser(1), newTestUser(2), newTestUser(3)
+
+	game, err := service.CreateGame(user1, model.GameOptions{})
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, user2)
+	require.NoError(t, err)
+	require.Empty(t, service.GetRunningGames(spectator.Id()))
+
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+
+	// only started games the user is not part of can be spectated
+	running := service.GetRunningGames(spectator.Id())
+	require.Len(t, running, 1)
+	require.Equal(t, game.Id(), running[0].Id())
+	require.Empty(t, service.GetRunningGames(user1.Id()))
+}
+
+func TestPrivateGame(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	owner, guest, other := newTestUser(1), newTestUser(2), newTestUser(3)
+
+	game, err := service.CreateGame(owner, model.GameOptions{Private: true})
+	require.NoError(t, err)
+	require.True(t, game.IsPrivate())
+	require.NotEmpty(t, game.InviteCode())
+
+	// hidden from the lobby
+	require.Empty(t, service.GetJoinableGames())
+
+	// only joined through the invite code
+	_, err = service.JoinGameId(game.Id(), guest)
+	require.ErrorIs(t, err, model.ErrPrivateGame)
+	_, err = service.JoinInviteCode("", guest)
+	require.ErrorIs(t, err, model.ErrMissingInviteCode)
+	_, err = service.JoinInviteCode(game.InviteCode()+"X", guest)
+	require.ErrorIs(t, err, model.ErrInvalidInviteCode)
+
+	game, err = service.JoinInviteCode(game.InviteCode(), guest)
+	require.NoError(t, err)
+	require.True(t, game.HasUser(guest.Id()))
+
+	// full
+	_, err = service.JoinInviteCode(game.InviteCode(), other)
+	require.ErrorIs(t, err, model.ErrInvalidInviteCode)
+
+	// public games get no invite code
+	public, err := service.CreateGame(other, model.GameOptions{})
+	require.NoError(t, err)
+	require.False(t, public.IsPrivate())
+	require.Len(t, service.GetJoinableGames(), 1)
+}
+
+func TestDeletedGame(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	owner := newTestUser(1)
+	game, err := service.CreateGame(owner, model.GameOptions{})
+	require.NoError(t, err)
+
+	// the game read by the caller is deleted before it is locked
+	_, err = service.LeavePlayerGame(newTestPlayer(game.Id(), owner))
+	require.NoError(t, err)
+
+	_, err = service.JoinGame(game, newTestUser(2))
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	_, err = service.StartGame(game)
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	_, err = service.LeaveGame(game, newTestPlayer(game.Id(), owner))
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	_, err = service.UpdateSettings(game, newTestPlayer(game.Id(), owner), model.GameOptions{Private: true})
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	_, err = service.SetPlayerReady(newTestPlayer(game.Id(), owner), true)
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	require.ErrorIs(t, service.DeleteGame(game, newTestPlayer(game.Id(), owner).Id()), model.ErrGameNotFound)
+
+	// nothing is stored again
+	_, err = service.GetGame(game.Id())
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// update settings
+
+func (s *gameService[PlayerT, GameT]) UpdatePlayerSettings(player PlayerT, options model.GameOptions) (GameT, error) {
+	game, err := s.gameStore.Get(player.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+	return s.UpdateSettings(game, player, options)
+}
+
+// UpdateSettings lets the host change the turn timeout, the privacy & the ready check of a game not started yet.
+// The other options shape the game itself and are kept from its creation.
+func (s *gameService[PlayerT, GameT]) UpdateSettings(game GameT, player PlayerT, options model.GameOptions) (GameT, error) {
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return s.empty, err
+	}
+
+	// a private game keeps its invite code
+	inviteCode := model.InviteCode("")
+	if options.Private {
+		inviteCode = game.InviteCode()
+		if inviteCode == "" {
+			inviteCode = model.GenerateInviteCode()
+		}
+	}
+	return s.updateSettings(game, player, options.TurnTimeout, inviteCode, options.ReadyCheck)
+}
+
+func (s *gameService[PlayerT, GameT]) updateSettings(game GameT, player PlayerT, turnTimeout time.Duration, inviteCode model.InviteCode, readyCheck bool) (GameT, error) {
+
+	s.logger.Info(fmt.Sprintf("[DEBUG] >>> update-settings :: game %s %s :: player %s", game.Id(), game.Status().String(), player.Id()))
+	defer func() {
+		s.logger.Info(fmt.Sprintf("[DEBUG] <<< update-settings :: game %s %s :: player %s", game.Id(), game.Status().String(), player.Id()))
+	}()
+
+	//
+	// check status
+	//
+
+	if game.WasStarted() {
+		return s.empty, model.ErrGameAlreadyStarted
+	}
+
+	//
+	// check host
+	//
+
+	if !game.IsHost(player.Id()) {
+		return s.empty, model.ErrNotHost
+	}
+
+	//
+	// update settings
+	//
+
+	game.UpdateSettings(turnTimeout, inviteCode, readyCheck)
+
+	//
+	// save game
+	//
+
+	game, err := s.SaveGame(game)
+	if err != nil {
+		return s.empty, err
+	}
+
+	//
+	// callbacks
+	//
+
+	s.onEvent(game, model.EventType_UpdateSettings, player.Id(), model.UpdateSettingsPayload{
+		TurnTimeout: turnTimeout,
+		InviteCode:  inviteCode,
+		ReadyCheck:  readyCheck,
+	})
+	s.onGame(game)
+
+	return game, nil
+}
+
+// //////////////////////////////////////////////////
+// kick player
+
+// KickPlayer lets the host remove another player from a game not started yet.
+func (s *gameService[PlayerT, GameT]) KickPlayer(host PlayerT, playerId model.PlayerId) (GameT, error) {
+	unlock := s.lockGame(host.GameId())
+	defer unlock()
+
+	game, err := s.gameStore.Get(host.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+
+	s.logger.Info(fmt.Sprintf("[DEBUG] >>> kick-player :: game %s %s :: host %s :: player %s", game.Id(), game.Status().String(), host.Id(), playerId))
+	defer func() {
+		s.logger.Info(fmt.Sprintf("[DEBUG] <<< kick-player :: game %s %s :: host %s :: player %s", game.Id(), game.Status().String(), host.Id(), playerId))
+	}()
+
+	//
+	// check status
+	//
+
+	if game.WasStarted() {
+		return s.empty, model.ErrGameAlreadyStarted
+	}
+
+	//
+	// check players
+	//
+
+	if !game.IsHost(host.Id()) {
+		return s.empty, model.ErrNotHost
+	}
+	if playerId == host.Id() {
+		return s.empty, model.ErrCannotKickHost
+	}
+	player, found := game.Player(playerId)
+	if !found {
+		return s.empty, model.ErrPlayerNotInGame
+	}
+
+	//
+	// kick player
+	//
+
+	game.DetachPlayer(player)
+	game.UpdateJoinStatus()
+
+	//
+	// save game
+	//
+
+	game, err = s.SaveGame(game)
+	if err != nil {
+		return s.empty, err
+	}
+
+	//
+	// callbacks
+	//
+
+	s.onEvent(game, model.EventType_KickPlayer, host.Id(), model.KickPlayerPayload{
+		PlayerId: playerId,
+	})
+	s.onKickPlayer(game, playerId.UserId())
+
+	return game, nil
+}
+
+func (s *gameService[PlayerT, GameT]) RegisterOnKickPlayer(onKickFn func(game GameT, userId model.UserId)) {
+	s.onKickFns = append(s.onKickFns, onKickFn)
+}
+
+func (s *gameService[PlayerT, GameT]) onKickPlayer(game GameT, userId model.UserId) {
+	for _, onKickFn := range s.onKickFns {
+		onKickFn(game, userId)
+	}
+}
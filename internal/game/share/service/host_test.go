@@ -0,0 +1,78 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestHost(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	eventStore := recordTestEvents(t, service)
+	kicked := []model.UserId{}
+	service.RegisterOnKickPlayer(func(game *testGame, userId model.UserId) {
+		kicked = append(kicked, userId)
+	})
+
+	user1, user2 := newTestUser(1), newTestUser(2)
+	game, err := service.CreateGame(user1, model.GameOptions{})
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, user2)
+	require.NoError(t, err)
+	host, guest := newTestPlayer(game.Id(), user1), newTestPlayer(game.Id(), user2)
+	require.True(t, game.IsHost(host.Id()))
+	require.False(t, game.IsHost(guest.Id()))
+
+	// only the host can start, change settings & kick
+	_, err = service.StartPlayerGame(guest)
+	require.ErrorIs(t, err, model.ErrNotHost)
+	_, err = service.UpdatePlayerSettings(guest, model.GameOptions{Private: true})
+	require.ErrorIs(t, err, model.ErrNotHost)
+	_, err = service.KickPlayer(guest, host.Id())
+	require.ErrorIs(t, err, model.ErrNotHost)
+	_, err = service.KickPlayer(host, host.Id())
+	require.ErrorIs(t, err, model.ErrCannotKickHost)
+
+	// settings
+	game, err = service.UpdatePlayerSettings(host, model.GameOptions{Private: true, TurnTimeout: 30 * time.Second})
+	require.NoError(t, err)
+	require.True(t, game.IsPrivate())
+	require.Equal(t, 30*time.Second, game.TurnTimeout())
+	inviteCode := game.InviteCode()
+	game, err = service.UpdatePlayerSettings(host, model.GameOptions{Private: true})
+	require.NoError(t, err)
+	require.Equal(t, inviteCode, game.InviteCode(), "a private game keeps its invite code")
+	require.Zero(t, game.TurnTimeout())
+
+	// kick
+	game, err = service.KickPlayer(host, guest.Id())
+	require.NoError(t, err)
+	require.False(t, game.HasUser(user2.Id()))
+	require.Equal(t, model.GameStatus_JoinableNotStartable, game.Status())
+	require.Equal(t, []model.UserId{user2.Id()}, kicked)
+
+	// the host leaving passes the game on
+	_, err = service.JoinGame(game, user2)
+	require.NoError(t, err)
+	game, err = service.LeavePlayerGame(host)
+	require.NoError(t, err)
+	require.True(t, game.IsHost(guest.Id()))
+
+	replayed := replayTestGame(t, eventStore, game.Id())
+	require.Equal(t, game.HostId(), replayed.HostId())
+	require.Equal(t, game.InviteCode(), replayed.InviteCode())
+
+	// nothing changes once started
+	_, err = service.JoinGame(game, user1)
+	require.NoError(t, err)
+	_, err = service.StartPlayerGame(guest)
+	require.NoError(t, err)
+	_, err = service.KickPlayer(guest, newTestPlayer(game.Id(), user1).Id())
+	require.ErrorIs(t, err, model.ErrGameAlreadyStarted)
+	_, err = service.UpdatePlayerSettings(guest, model.GameOptions{})
+	require.ErrorIs(t, err, model.ErrGameAlreadyStarted)
+}
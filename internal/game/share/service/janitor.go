@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// janitor
+
+// JanitorConfig tells how often games are checked and how long a game may stay idle in each status.
+// A status without ttl ( or with a zero ttl ) never expires.
+type JanitorConfig struct {
+	Period time.Duration
+	TTLs   map[model.GameStatus]time.Duration
+}
+
+// StartJanitor periodically expires idle games until ctx is done.
+func (s *gameService[PlayerT, GameT]) StartJanitor(ctx context.Context, config JanitorConfig) {
+	if config.Period <= 0 || len(config.TTLs) == 0 {
+		s.logger.Info("[janitor] disabled")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(config.Period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.ExpireGames(config.TTLs, now)
+			}
+		}
+	}()
+}
+
+// ExpireGames deletes the games idle for longer than the ttl of their status and returns how many were expired.
+// A game is idle since it was last stored or the last activity reported by the registered activity functions.
+// The time it was last stored is persisted with the game, so that games reloaded at startup are not expired right away.
+func (s *gameService[PlayerT, GameT]) ExpireGames(ttls map[model.GameStatus]time.Duration, now time.Time) int {
+	nbExpired := 0
+	for status, ttl := range ttls {
+		if ttl <= 0 {
+			continue
+		}
+		for _, game := range s.gameStore.ListStatus(status) {
+			if s.expireGame(game, ttls, now) {
+				nbExpired++
+			}
+		}
+	}
+	return nbExpired
+}
+
+func (s *gameService[PlayerT, GameT]) expireGame(game GameT, ttls map[model.GameStatus]time.Duration, now time.Time) bool {
+
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	//
+	// check expiration
+	//
+
+	// the game may have changed since it was listed
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return false
+	}
+	ttl := ttls[game.Status()]
+	if ttl <= 0 {
+		return false
+	}
+	idle := now.Sub(s.lastActivity(game))
+	if idle < ttl {
+		return false
+	}
+
+	s.logger.Info(fmt.Sprintf("[janitor] game %s %s idle for %s >>> EXPIRED", game.Id(), game.Status().String(), idle.Round(time.Second)))
+
+	//
+	// delete game
+	//
+
+	game.MarkForDeletion()
+	if err := s.deleteGame(game); err != nil {
+		s.logger.Error(fmt.Sprintf("[janitor] unable to delete game %s", game.Id()), zap.Error(err))
+		return false
+	}
+
+	//
+	// callbacks
+	//
+
+	for _, player := range game.Players() {
+		s.onLeaveGame(game, player.Id().UserId())
+	}
+	s.onGame(game)
+
+	return true
+}
+
+func (s *gameService[PlayerT, GameT]) lastActivity(game GameT) time.Time {
+	last := game.CreatedAt()
+	if game.UpdatedAt().After(last) {
+		last = game.UpdatedAt()
+	}
+	for _, activityFn := range s.activityFns {
+		if activity := activityFn(game); activity.After(last) {
+			last = activity
+		}
+	}
+	return last
+}
+
+// //////////////////////////////////////////////////
+// activity
+
+func (s *gameService[PlayerT, GameT]) RegisterGameActivity(activityFn func(game GameT) time.Time) {
+	s.activityFns = append(s.activityFns, activityFn)
+}
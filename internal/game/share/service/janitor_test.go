@@ -0,0 +1,75 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestExpireGames(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	joinable, err := service.CreateGame(newTestUser(1), model.GameOptions{})
+	require.NoError(t, err)
+	started, err := service.CreateGame(newTestUser(2), model.GameOptions{})
+	require.NoError(t, err)
+	_, err = service.JoinGame(started, newTestUser(3))
+	require.NoError(t, err)
+	_, err = service.StartGame(started)
+	require.NoError(t, err)
+
+	var left []model.UserId
+	service.RegisterOnLeaveGame(func(game *testGame, userId model.UserId) {
+		left = append(left, userId)
+	})
+
+	ttls := map[model.GameStatus]time.Duration{
+		model.GameStatus_JoinableNotStartable: time.Minute,
+		model.GameStatus_Started:              time.Hour,
+	}
+
+	// not idle long enough
+	require.Equal(t, 0, service.ExpireGames(ttls, time.Now()))
+
+	// joinable game expired
+	now := time.Now().Add(2 * time.Minute)
+	require.Equal(t, 1, service.ExpireGames(ttls, now))
+	_, err = service.GetGame(joinable.Id())
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	require.Equal(t, []model.UserId{"U1"}, left)
+
+	// recent activity keeps the started game alive
+	now = time.Now().Add(2 * time.Hour)
+	service.RegisterGameActivity(func(game *testGame) time.Time {
+		return now.Add(-time.Minute)
+	})
+	require.Equal(t, 0, service.ExpireGames(ttls, now))
+	_, err = service.GetGame(started.Id())
+	require.NoError(t, err)
+}
+
+func TestExpireReloadedGames(t *testing.T) {
+
+	dir := t.TempDir()
+	clock := newTestClock()
+	clock.now = time.Now()
+	service := newTestFileService(t, dir, clock)
+	game, err := service.CreateGame(newTestUser(1), model.GameOptions{})
+	require.NoError(t, err)
+
+	// the game is played long after its creation
+	clock.Advance(time.Hour)
+	_, err = service.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+
+	// the server restarts: the activity of the game is gone with it, but not the time it was last stored
+	restarted := newTestFileService(t, dir, clock)
+	ttls := map[model.GameStatus]time.Duration{
+		model.GameStatus_NotJoinableAndStartable: time.Minute,
+	}
+	require.Equal(t, 0, restarted.ExpireGames(ttls, clock.Now().Add(59*time.Second)))
+	require.Equal(t, 1, restarted.ExpireGames(ttls, clock.Now().Add(time.Minute)))
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// ready
+
+// SetPlayerReady toggles whether a player is ready for the game to start.
+// Games with a ready check only start once every player is ready.
+func (s *gameService[PlayerT, GameT]) SetPlayerReady(player PlayerT, ready bool) (GameT, error) {
+	unlock := s.lockGame(player.GameId())
+	defer unlock()
+
+	game, err := s.gameStore.Get(player.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+
+	s.logger.Info(fmt.Sprintf("[DEBUG] >>> set-ready :: game %s %s :: player %s :: ready %t", game.Id(), game.Status().String(), player.Id(), ready))
+	defer func() {
+		s.logger.Info(fmt.Sprintf("[DEBUG] <<< set-ready :: game %s %s :: player %s :: ready %t", game.Id(), game.Status().String(), player.Id(), ready))
+	}()
+
+	//
+	// check status
+	//
+
+	if game.WasStarted() {
+		return s.empty, model.ErrGameAlreadyStarted
+	}
+
+	//
+	// check player
+	//
+
+	player, found := game.Player(player.Id())
+	if !found {
+		return s.empty, model.ErrPlayerNotInGame
+	}
+
+	//
+	// set ready
+	//
+
+	player.SetReady(ready)
+
+	//
+	// save game
+	//
+
+	game, err = s.SaveGame(game)
+	if err != nil {
+		return s.empty, err
+	}
+
+	//
+	// callbacks
+	//
+
+	s.onEvent(game, model.EventType_SetReady, player.Id(), model.SetReadyPayload{
+		Ready: ready,
+	})
+	s.onGame(game)
+
+	return game, nil
+}
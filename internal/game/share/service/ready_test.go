@@ -0,0 +1,114 @@
+package service
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestReadyCheck(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	eventStore := recordTestEvents(t, service)
+
+	user1, user2 := newTestUser(1), newTestUser(2)
+	game, err := service.CreateGame(user1, model.GameOptions{ReadyCheck: true})
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, user2)
+	require.NoError(t, err)
+	host, guest := newTestPlayer(game.Id(), user1), newTestPlayer(game.Id(), user2)
+	require.True(t, game.HasReadyCheck())
+	require.True(t, game.CanStart())
+	require.False(t, game.IsReadyToStart())
+
+	_, err = service.StartPlayerGame(host)
+	require.ErrorIs(t, err, model.ErrPlayersNotReady)
+
+	// ready players show as such, without leaving the lobby status
+	game, err = service.SetPlayerReady(host, true)
+	require.NoError(t, err)
+	require.Equal(t, model.PlayerStatus_WaitingToStart, game.MustPlayer(host.Id()).Status())
+	require.Equal(t, model.PlayerStatus_Ready, game.MustPlayer(host.Id()).VisibleStatus())
+	require.Equal(t, "icon-valid", game.PlayerStatusIcon(host.Id()))
+	require.Equal(t, "icon-pause", game.PlayerStatusIcon(guest.Id()))
+	_, err = service.StartPlayerGame(host)
+	require.ErrorIs(t, err, model.ErrPlayersNotReady)
+
+	// toggle
+	_, err = service.SetPlayerReady(guest, true)
+	require.NoError(t, err)
+	require.True(t, game.IsReadyToStart())
+	_, err = service.SetPlayerReady(guest, false)
+	require.NoError(t, err)
+	require.False(t, game.IsReadyToStart())
+	_, err = service.SetPlayerReady(guest, true)
+	require.NoError(t, err)
+
+	game, err = service.StartPlayerGame(host)
+	require.NoError(t, err)
+	require.True(t, game.IsStarted())
+	_, err = service.SetPlayerReady(guest, false)
+	require.ErrorIs(t, err, model.ErrGameAlreadyStarted)
+
+	replayed := replayTestGame(t, eventStore, game.Id())
+	require.True(t, replayed.IsStarted())
+	require.Equal(t, game.Order(), replayed.Order())
+}
+
+func TestReadyCheckBot(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	service.SetBot(&testBot{})
+	service.SetBotDelay(-1)
+	user := newTestUser(1)
+	game, err := service.CreateGame(user, model.GameOptions{ReadyCheck: true})
+	require.NoError(t, err)
+	host := newTestPlayer(game.Id(), user)
+	game, err = service.AddBot(host)
+	require.NoError(t, err)
+
+	// bots are always ready
+	require.False(t, game.IsEveryoneReady())
+	game, err = service.SetPlayerReady(host, true)
+	require.NoError(t, err)
+	require.True(t, game.IsEveryoneReady())
+	_, err = service.StartPlayerGame(host)
+	require.NoError(t, err)
+}
+
+func TestSetReadyDeletedWhileLocked(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	owner := newTestUser(1)
+	game, err := service.CreateGame(owner, model.GameOptions{ReadyCheck: true})
+	require.NoError(t, err)
+	gameId := game.Id()
+
+	// the game is deleted while the player waits for its lock
+	unlock := service.lockGame(gameId)
+	errs := make(chan error)
+	go func() {
+		_, err := service.SetPlayerReady(newTestPlayer(gameId, owner), true)
+		errs <- err
+	}()
+	for nbTestLockRef(service, gameId) < 2 {
+		runtime.Gosched()
+	}
+	require.NoError(t, service.gameStore.Delete(gameId))
+	unlock()
+
+	require.ErrorIs(t, <-errs, model.ErrGameNotFound)
+}
+
+// nbTestLockRef tells how many callers hold or wait for the lock of a game.
+func nbTestLockRef(s *gameService[*model.BasePlayer, *testGame], id model.GameId) int {
+	s.locksMutex.Lock()
+	defer s.locksMutex.Unlock()
+	if lock, found := s.locks[id]; found {
+		return lock.nbRef
+	}
+	return 0
+}
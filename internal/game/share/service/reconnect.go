@@ -0,0 +1,139 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// reconnect
+
+// SetReconnectGrace sets how long a disconnected player keeps their seat before leaving the game.
+// A zero grace keeps disconnected players in the game until they leave on their own.
+func (s *gameService[PlayerT, GameT]) SetReconnectGrace(grace time.Duration) {
+	s.reconnectGrace = grace
+}
+
+// DisconnectPlayer flags a player as reconnecting and makes them leave the game
+// unless they reconnect within the grace window.
+func (s *gameService[PlayerT, GameT]) DisconnectPlayer(playerId model.PlayerId) (GameT, error) {
+	if s.reconnectGrace <= 0 {
+		return s.gameStore.Get(playerId.GameId())
+	}
+
+	game, err := s.flagReconnecting(playerId)
+	if err != nil {
+		return s.empty, err
+	}
+
+	s.logger.Info(fmt.Sprintf("[reconnect] game %s :: player %s >>> DISCONNECTED ( grace %s )", game.Id(), playerId, s.reconnectGrace))
+	s.startReconnectTimer(playerId)
+
+	s.onGame(game)
+	return game, nil
+}
+
+// ReconnectPlayer gives back the seat of a player that reconnected within the grace window.
+func (s *gameService[PlayerT, GameT]) ReconnectPlayer(playerId model.PlayerId) (GameT, error) {
+	s.stopReconnectTimer(playerId)
+
+	// the grace timer may have fired already and wait for the game lock:
+	// the flag is cleared under the lock, even without a timer, so that it keeps the seat
+	unlock := s.lockGame(playerId.GameId())
+	defer unlock()
+
+	game, err := s.gameStore.Get(playerId.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+	player, found := game.Player(playerId)
+	if !found || !player.IsReconnecting() {
+		return game, nil
+	}
+	player.SetReconnecting(false)
+	game, err = s.SaveGame(game)
+	if err != nil {
+		return s.empty, err
+	}
+
+	s.logger.Info(fmt.Sprintf("[reconnect] game %s :: player %s >>> RECONNECTED", game.Id(), playerId))
+
+	s.onGame(game)
+	return game, nil
+}
+
+func (s *gameService[PlayerT, GameT]) flagReconnecting(playerId model.PlayerId) (GameT, error) {
+	unlock := s.lockGame(playerId.GameId())
+	defer unlock()
+
+	game, err := s.gameStore.Get(playerId.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+	player, found := game.Player(playerId)
+	if !found {
+		return s.empty, model.ErrPlayerNotInGame
+	}
+	player.SetReconnecting(true)
+	return s.SaveGame(game)
+}
+
+// expireReconnect makes a player that did not reconnect in time leave the game, as if they left on their own.
+func (s *gameService[PlayerT, GameT]) expireReconnect(playerId model.PlayerId) {
+	if !s.stopReconnectTimer(playerId) {
+		// reconnected in the meantime
+		return
+	}
+	s.leaveReconnecting(playerId)
+}
+
+// leaveReconnecting makes a player leave the game unless they reconnected since their grace timer fired.
+func (s *gameService[PlayerT, GameT]) leaveReconnecting(playerId model.PlayerId) {
+	unlock := s.lockGame(playerId.GameId())
+	defer unlock()
+
+	game, err := s.gameStore.Get(playerId.GameId())
+	if err != nil {
+		return
+	}
+	player, found := game.Player(playerId)
+	if !found || !player.IsReconnecting() {
+		return
+	}
+
+	s.logger.Info(fmt.Sprintf("[reconnect] game %s :: player %s >>> EXPIRED", game.Id(), playerId))
+	player.SetReconnecting(false)
+	if _, err := s.leaveGame(game, player); err != nil {
+		s.logger.Error(fmt.Sprintf("[reconnect] unable to remove player %s", playerId), zap.Error(err))
+	}
+}
+
+func (s *gameService[PlayerT, GameT]) startReconnectTimer(playerId model.PlayerId) {
+	s.reconnectsMutex.Lock()
+	defer s.reconnectsMutex.Unlock()
+
+	if timer, found := s.reconnects[playerId]; found {
+		timer.Stop()
+	}
+	s.reconnects[playerId] = s.clock.AfterFunc(s.reconnectGrace, func() {
+		s.expireReconnect(playerId)
+	})
+}
+
+// stopReconnectTimer stops the grace timer of a player and tells whether one was running.
+func (s *gameService[PlayerT, GameT]) stopReconnectTimer(playerId model.PlayerId) bool {
+	s.reconnectsMutex.Lock()
+	defer s.reconnectsMutex.Unlock()
+
+	timer, found := s.reconnects[playerId]
+	if !found {
+		return false
+	}
+	timer.Stop()
+	delete(s.reconnects, playerId)
+	return true
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestReconnectGrace(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	clock := newTestClock()
+	service.SetClock(clock)
+	service.SetReconnectGrace(time.Minute)
+	var left []model.UserId
+	service.RegisterOnLeaveGame(func(game *testGame, userId model.UserId) {
+		left = append(left, userId)
+	})
+
+	game := newStartedTestGame(t, service, model.GameOptions{})
+	gameId := game.Id()
+	playerId := model.NewPlayerId(gameId, newTestUser(1).Id())
+
+	// reconnecting within the grace window keeps the seat
+	game, err := service.DisconnectPlayer(playerId)
+	require.NoError(t, err)
+	player := game.MustPlayer(playerId)
+	require.True(t, player.IsReconnecting())
+	require.Equal(t, model.PlayerStatus_Reconnecting, player.VisibleStatus())
+
+	clock.Advance(30 * time.Second)
+	game, err = service.ReconnectPlayer(playerId)
+	require.NoError(t, err)
+	player = game.MustPlayer(playerId)
+	require.False(t, player.IsReconnecting())
+	require.NotEqual(t, model.PlayerStatus_Reconnecting, player.VisibleStatus())
+
+	// the grace window started over, then not reconnecting in time leaves the game
+	_, err = service.DisconnectPlayer(playerId)
+	require.NoError(t, err)
+	clock.Advance(59 * time.Second)
+	require.Empty(t, left)
+	clock.Advance(time.Second)
+	require.Equal(t, []model.UserId{"U1"}, left)
+
+	game, err = service.GetGame(gameId)
+	require.NoError(t, err)
+	require.True(t, game.IsStopped())
+	player = game.MustPlayer(playerId)
+	require.False(t, player.IsReconnecting())
+	require.True(t, player.Result().IsLoose())
+
+	// reconnecting too late does nothing
+	_, err = service.ReconnectPlayer(playerId)
+	require.NoError(t, err)
+}
+
+func TestReconnectWhileExpiring(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	service.SetClock(newTestClock())
+	service.SetReconnectGrace(time.Minute)
+	var left []model.UserId
+	service.RegisterOnLeaveGame(func(game *testGame, userId model.UserId) {
+		left = append(left, userId)
+	})
+
+	game := newStartedTestGame(t, service, model.GameOptions{})
+	playerId := model.NewPlayerId(game.Id(), newTestUser(1).Id())
+	_, err := service.DisconnectPlayer(playerId)
+	require.NoError(t, err)
+
+	// the grace timer fires and drops its entry, then the player reconnects before it takes the game lock
+	require.True(t, service.stopReconnectTimer(playerId))
+	game, err = service.ReconnectPlayer(playerId)
+	require.NoError(t, err)
+	require.False(t, game.MustPlayer(playerId).IsReconnecting())
+	service.leaveReconnecting(playerId)
+
+	// the reconnected player keeps their seat
+	require.Empty(t, left)
+	game, err = service.GetGame(game.Id())
+	require.NoError(t, err)
+	require.False(t, game.IsStopped())
+	require.False(t, game.MustPlayer(playerId).IsReconnecting())
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// rematch
+
+func (s *gameService[PlayerT, GameT]) RematchPlayerGame(player PlayerT) (GameT, error) {
+	game, err := s.gameStore.Get(player.GameId())
+	if err != nil {
+		return s.empty, err
+	}
+	return s.RematchGame(game, player)
+}
+
+// RematchGame seats the players still at the table of a stopped game in a fresh game with the same options.
+// The first player asking creates the rematch for everyone: the order is rotated by one and the results are carried over.
+// Players asking afterwards are given the same rematch.
+func (s *gameService[PlayerT, GameT]) RematchGame(game GameT, player PlayerT) (GameT, error) {
+	unlock := s.lockGame(game.Id())
+	defer unlock()
+
+	// the game may have changed since the caller read it
+	game, err := s.gameStore.Get(game.Id())
+	if err != nil {
+		return s.empty, err
+	}
+
+	s.logger.Info(fmt.Sprintf("[DEBUG] >>> rematch-game :: game %s %s :: player %s", game.Id(), game.Status().String(), player.Id()))
+	defer func() {
+		s.logger.Info(fmt.Sprintf("[DEBUG] <<< rematch-game :: game %s %s :: rematch %s", game.Id(), game.Status().String(), game.RematchId()))
+	}()
+
+	//
+	// check status
+	//
+
+	if !game.IsStopped() {
+		return s.empty, model.ErrGameNotStopped
+	}
+
+	//
+	// check player
+	//
+
+	player, found := game.Player(player.Id())
+	if !found {
+		return s.empty, model.ErrPlayerNotInGame
+	}
+
+	//
+	// join the rematch created by another player
+	//
+
+	if game.HasRematch() {
+		rematch, err := s.gameStore.Get(game.RematchId())
+		if err != nil {
+			return s.empty, err
+		}
+		if rematch.HasUser(player.Id().UserId()) {
+			return rematch, nil
+		}
+		return s.JoinGame(rematch, player.User())
+	}
+
+	//
+	// create the rematch, then seat the other players still at the table
+	//
+
+	setup := model.NewGameSetup(game.Options())
+	setup.Settings = game.Settings()
+	next := game.NextRematch()
+	setup.Rematch = &next
+
+	rematch, err := s.createGame(player.User(), setup)
+	if err != nil {
+		return s.empty, err
+	}
+	for _, userId := range next.Seats {
+		other, found := game.Player(model.NewPlayerId(game.Id(), userId))
+		if !found || other.Id() == player.Id() || !s.isAtTable(game, other) {
+			continue
+		}
+		rematch, err = s.JoinGame(rematch, other.User())
+		if err != nil {
+			return s.empty, err
+		}
+	}
+
+	//
+	// link the stopped game to its rematch
+	//
+
+	game.SetRematchId(rematch.Id())
+	if _, err = s.storeGame(game); err != nil {
+		return s.empty, err
+	}
+
+	return rematch, nil
+}
+
+// //////////////////////////////////////////////////
+// at table
+
+// RegisterIsAtTable registers a check telling whether a player of a stopped game is still at the table ( e.g. still looking at the game ).
+// Players that are no longer at the table are left out of the rematch.
+func (s *gameService[PlayerT, GameT]) RegisterIsAtTable(isAtTableFn func(game GameT, player PlayerT) bool) {
+	s.isAtTableFns = append(s.isAtTableFns, isAtTableFn)
+}
+
+func (s *gameService[PlayerT, GameT]) isAtTable(game GameT, player PlayerT) bool {
+	for _, isAtTableFn := range s.isAtTableFns {
+		if !isAtTableFn(game, player) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestRematch(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	eventStore := recordTestEvents(t, service)
+	joined := map[model.UserId]model.GameId{}
+	service.RegisterOnJoinGame(func(game *testGame, player *model.BasePlayer) {
+		joined[player.Id().UserId()] = game.Id()
+	})
+
+	options := model.GameOptions{TurnTimeout: time.Minute}
+	game := newStartedTestGame(t, service, options)
+
+	_, err := service.RematchPlayerGame(game.RoundPlayer())
+	require.ErrorIs(t, err, model.ErrGameNotStopped)
+
+	// the player making the last move wins
+	for !game.IsStopped() {
+		_, err = playTestMove(service, game.RoundPlayer(), game.Version())
+		require.NoError(t, err)
+	}
+	winnerId := game.Moves[len(game.Moves)-1]
+	winner, loser := game.MustPlayer(winnerId), game.MustPlayer(game.Moves[len(game.Moves)-2])
+	require.True(t, winner.Result().IsWin())
+
+	// the loser asks for a rematch: both players are seated in a fresh game with the same options
+	rematch, err := service.RematchPlayerGame(loser)
+	require.NoError(t, err)
+	require.NotEqual(t, game.Id(), rematch.Id())
+	require.Equal(t, game.Id(), rematch.PreviousGameId())
+	require.Equal(t, rematch.Id(), game.RematchId())
+	require.Equal(t, options, rematch.Options())
+	require.Equal(t, 2, rematch.NbPlayer())
+	require.Equal(t, rematch.Id(), joined[winner.Id().UserId()])
+	require.Equal(t, rematch.Id(), joined[loser.Id().UserId()])
+
+	// asking again gives the same rematch
+	again, err := service.RematchPlayerGame(winner)
+	require.NoError(t, err)
+	require.Equal(t, rematch.Id(), again.Id())
+
+	// the order is rotated by one & the results are carried over
+	_, err = service.StartGame(rematch)
+	require.NoError(t, err)
+	require.Equal(t, game.OrderedPlayerId(1).UserId(), rematch.OrderedPlayerId(0).UserId())
+	require.Equal(t, game.OrderedPlayerId(0).UserId(), rematch.OrderedPlayerId(1).UserId())
+	require.Equal(t, 1, rematch.NbTableGame())
+	require.Equal(t, 1, rematch.NbTableWin(model.NewPlayerId(rematch.Id(), winner.Id().UserId())))
+	require.Equal(t, 0, rematch.NbTableWin(model.NewPlayerId(rematch.Id(), loser.Id().UserId())))
+
+	// a replayed rematch is seated identically
+	replayed := replayTestGame(t, eventStore, rematch.Id())
+	require.Equal(t, rematch.Order(), replayed.Order())
+	require.Equal(t, rematch.History(), replayed.History())
+}
+
+func TestRematchLeftPlayer(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	user1, user2 := newTestUser(1), newTestUser(2)
+	service.RegisterIsAtTable(func(game *testGame, player *model.BasePlayer) bool {
+		return player.Id().UserId() != user2.Id()
+	})
+
+	game := newStartedTestGame(t, service, model.GameOptions{})
+	_, err := service.LeavePlayerGame(newTestPlayer(game.Id(), user2))
+	require.NoError(t, err)
+	require.True(t, game.IsStopped())
+
+	// players no longer at the table are left out
+	rematch, err := service.RematchPlayerGame(newTestPlayer(game.Id(), user1))
+	require.NoError(t, err)
+	require.True(t, rematch.HasUser(user1.Id()))
+	require.False(t, rematch.HasUser(user2.Id()))
+	require.False(t, rematch.CanStart())
+}
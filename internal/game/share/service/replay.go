@@ -0,0 +1,155 @@
+package service
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+	"github.com/gre-ory/games-go/internal/game/share/store"
+)
+
+// //////////////////////////////////////////////////
+// replay
+
+// Replay rebuilds a game by applying its recorded events in order, in a service of its own backed by a memory store.
+// Shared events are applied by the service itself, game specific ones are turned into the action to play by replayFn.
+// The replay service has no callbacks, no turn timer and no bot: timeouts and bot actions are replayed from their own events.
+func Replay[PlayerT model.Player, GameT model.Game[PlayerT]](logger *zap.Logger, plugin GamePlugin[PlayerT, GameT], events []model.Event, replayFn func(event model.Event) (func(game GameT, player PlayerT) (bool, error), error)) (GameT, error) {
+	s := newGameService(logger, plugin, store.NewGameMemoryStore[GameT]())
+	s.noTurnTimer = true
+	if len(events) == 0 {
+		return s.empty, model.ErrMissingEvents
+	}
+	for _, event := range events {
+		if err := s.replayEvent(event, replayFn); err != nil {
+			return s.empty, err
+		}
+	}
+	return s.GetGame(events[0].GameId)
+}
+
+func (s *gameService[PlayerT, GameT]) replayEvent(event model.Event, replayFn func(event model.Event) (func(game GameT, player PlayerT) (bool, error), error)) error {
+	switch event.Type {
+	case model.EventType_CreateGame:
+		var payload model.CreateGamePayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return err
+		}
+		_, err := s.createGame(payload.User.User(), payload.Setup)
+		return err
+	case model.EventType_JoinGame:
+		var payload model.JoinGamePayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return err
+		}
+		// private games were joined through their invite code
+		game, err := s.GetGame(event.GameId)
+		if err != nil {
+			return err
+		}
+		_, err = s.JoinGame(game, payload.User.User())
+		return err
+	case model.EventType_StartGame:
+		game, err := s.GetGame(event.GameId)
+		if err != nil {
+			return err
+		}
+		_, err = s.StartGame(game)
+		return err
+	case model.EventType_UpdateSettings:
+		var payload model.UpdateSettingsPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return err
+		}
+		player, err := s.GetPlayer(event.PlayerId)
+		if err != nil {
+			return err
+		}
+		game, err := s.GetGame(event.GameId)
+		if err != nil {
+			return err
+		}
+		unlock := s.lockGame(game.Id())
+		defer unlock()
+		_, err = s.updateSettings(game, player, payload.TurnTimeout, payload.InviteCode, payload.ReadyCheck)
+		return err
+	case model.EventType_SetReady:
+		var payload model.SetReadyPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return err
+		}
+		player, err := s.GetPlayer(event.PlayerId)
+		if err != nil {
+			return err
+		}
+		_, err = s.SetPlayerReady(player, payload.Ready)
+		return err
+	case model.EventType_KickPlayer:
+		var payload model.KickPlayerPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return err
+		}
+		host, err := s.GetPlayer(event.PlayerId)
+		if err != nil {
+			return err
+		}
+		_, err = s.KickPlayer(host, payload.PlayerId)
+		return err
+	case model.EventType_LeaveGame:
+		player, err := s.GetPlayer(event.PlayerId)
+		if err != nil {
+			return err
+		}
+		_, err = s.LeavePlayerGame(player)
+		return err
+	case model.EventType_TurnTimeout:
+		player, err := s.GetPlayer(event.PlayerId)
+		if err != nil {
+			return err
+		}
+		game, err := s.GetGame(event.GameId)
+		if err != nil {
+			return err
+		}
+		unlock := s.lockGame(game.Id())
+		defer unlock()
+		_, err = s.timeoutTurn(game, player)
+		return err
+	case model.EventType_StopGame:
+		game, err := s.GetGame(event.GameId)
+		if err != nil {
+			return err
+		}
+		_, err = s.StopGame(game)
+		return err
+	default:
+		if replayFn == nil {
+			return model.ErrUnknownEvent
+		}
+		playFn, err := replayFn(event)
+		if err != nil {
+			return err
+		}
+		return s.replayPlay(event, playFn)
+	}
+}
+
+// replayPlay applies a recorded action as Play does, without any version to check: events are replayed in order.
+func (s *gameService[PlayerT, GameT]) replayPlay(event model.Event, playFn func(game GameT, player PlayerT) (bool, error)) error {
+	player, err := s.GetPlayer(event.PlayerId)
+	if err != nil {
+		return err
+	}
+
+	unlock := s.lockGame(event.GameId)
+	defer unlock()
+
+	game, err := s.gameStore.Get(event.GameId)
+	if err != nil {
+		return err
+	}
+	if err := game.Status().CanPlay(); err != nil {
+		return err
+	}
+	_, err = s.play(game, player, event.Type, event.Payload, playFn)
+	return err
+}
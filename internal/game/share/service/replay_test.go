@@ -0,0 +1,104 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+	"github.com/gre-ory/games-go/internal/game/share/store"
+)
+
+// recordTestEvents records the events of the service in a memory event store.
+func recordTestEvents(t *testing.T, s *gameService[*model.BasePlayer, *testGame]) store.EventStore {
+	eventStore := store.NewEventMemoryStore()
+	s.RegisterOnEvent(func(event model.Event) {
+		require.NoError(t, eventStore.Append(event))
+	})
+	return eventStore
+}
+
+func replayTestGame(t *testing.T, eventStore store.EventStore, gameId model.GameId) *testGame {
+	events, err := eventStore.List(gameId)
+	require.NoError(t, err)
+	replayed, err := Replay(zap.NewNop(), &testPlugin{}, events, replayTest)
+	require.NoError(t, err)
+	return replayed
+}
+
+func TestRecordEvents(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	eventStore := recordTestEvents(t, service)
+
+	game, err := service.CreateGame(newTestUser(1), model.GameOptions{})
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+	_, err = service.LeavePlayerGame(newTestPlayer(game.Id(), newTestUser(2)))
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, newTestUser(3))
+	require.NoError(t, err)
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+	player := game.RoundPlayer()
+	_, err = playTestMove(service, player, game.Version())
+	require.NoError(t, err)
+
+	events, err := eventStore.List(game.Id())
+	require.NoError(t, err)
+	types := make([]model.EventType, 0, len(events))
+	for _, event := range events {
+		require.Equal(t, game.Id(), event.GameId)
+		types = append(types, event.Type)
+	}
+	require.Equal(t, []model.EventType{
+		model.EventType_CreateGame,
+		model.EventType_JoinGame,
+		model.EventType_LeaveGame,
+		model.EventType_JoinGame,
+		model.EventType_StartGame,
+		testEventType_Play,
+	}, types)
+
+	play := events[len(events)-1]
+	require.Equal(t, player.Id(), play.PlayerId)
+	require.Equal(t, game.Version(), play.Version)
+
+	// replay up to the start
+	replayed, err := Replay(zap.NewNop(), &testPlugin{}, events[:4], replayTest)
+	require.NoError(t, err)
+	require.Equal(t, game.Id(), replayed.Id())
+	require.True(t, replayed.HasUser("U1"))
+	require.False(t, replayed.HasUser("U2"))
+	require.True(t, replayed.HasUser("U3"))
+	require.Equal(t, model.GameStatus_NotJoinableAndStartable, replayed.Status())
+
+	// replayed in a separate service
+	_, err = service.GetGame(game.Id())
+	require.NoError(t, err)
+}
+
+func TestReplayGame(t *testing.T) {
+
+	service := newTestService(&testPlugin{})
+	eventStore := recordTestEvents(t, service)
+
+	game := newStartedTestGame(t, service, model.GameOptions{})
+	for !game.IsStopped() {
+		_, err := playTestMove(service, game.RoundPlayer(), game.Version())
+		require.NoError(t, err)
+	}
+
+	replayed := replayTestGame(t, eventStore, game.Id())
+	require.Equal(t, game.Seed(), replayed.Seed())
+	require.Equal(t, game.Order(), replayed.Order())
+	require.Equal(t, game.Moves, replayed.Moves)
+	require.Equal(t, game.Status(), replayed.Status())
+	for _, player := range game.Players() {
+		replayedPlayer, found := replayed.Player(player.Id())
+		require.True(t, found)
+		require.Equal(t, player.Result(), replayedPlayer.Result())
+	}
+}
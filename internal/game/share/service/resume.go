@@ -0,0 +1,33 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// resume games
+
+// ResumeGames arms again the turn & bot timers of the started games, typically once reloaded from a durable store.
+// It is called once the service is set up, since bots only play once the bot agent & delay are set.
+func (s *gameService[PlayerT, GameT]) ResumeGames() {
+	for _, game := range s.gameStore.ListStatus(model.GameStatus_Started) {
+		s.resumeGame(game.Id())
+	}
+}
+
+func (s *gameService[PlayerT, GameT]) resumeGame(gameId model.GameId) {
+
+	unlock := s.lockGame(gameId)
+	defer unlock()
+
+	game, err := s.gameStore.Get(gameId)
+	if err != nil || !game.IsStarted() {
+		return
+	}
+
+	s.logger.Info(fmt.Sprintf("[resume] game %s :: round %d >>> RESUMED", game.Id(), game.Round()))
+	s.resumeTurnTimer(game)
+	s.updateBotTimer(game)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+	"github.com/gre-ory/games-go/internal/game/share/store"
+)
+
+// //////////////////////////////////////////////////
+// test codec
+
+type testGameData struct {
+	Game    model.GameSnapshot     `json:"game"`
+	Players []model.PlayerSnapshot `json:"players"`
+	Moves   []model.PlayerId       `json:"moves"`
+}
+
+type testCodec struct{}
+
+func (c testCodec) Encode(game *testGame) ([]byte, error) {
+	data := testGameData{
+		Game:  game.Snapshot(),
+		Moves: game.Moves,
+	}
+	for _, player := range game.Players() {
+		data.Players = append(data.Players, player.Snapshot())
+	}
+	return json.Marshal(data)
+}
+
+func (c testCodec) Decode(raw []byte) (*testGame, error) {
+	var data testGameData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	players := make([]*model.BasePlayer, 0, len(data.Players))
+	for _, player := range data.Players {
+		players = append(players, model.NewPlayerFromSnapshot(player))
+	}
+	return &testGame{
+		BaseGame: model.NewGameFromSnapshot(data.Game, players),
+		Moves:    data.Moves,
+	}, nil
+}
+
+// newTestFileService starts a service on the games stored in dir, as a restarted server does.
+func newTestFileService(t *testing.T, dir string, clock Clock) *gameService[*model.BasePlayer, *testGame] {
+	gameStore, err := store.NewGameFileStore[*testGame](zap.NewNop(), dir, testCodec{})
+	require.NoError(t, err)
+	service := newGameService[*model.BasePlayer, *testGame](zap.NewNop(), &testPlugin{}, gameStore)
+	service.SetClock(clock)
+	return service
+}
+
+func TestResumeTurnTimer(t *testing.T) {
+
+	dir := t.TempDir()
+	clock := newTestClock()
+	service := newTestFileService(t, dir, clock)
+	game := newStartedTestGame(t, service, model.GameOptions{TurnTimeout: 10 * time.Second})
+	gameId := game.Id()
+	playerId := game.RoundPlayerId()
+	deadline := game.TurnDeadline()
+
+	// the server restarts in the middle of the turn
+	clock.Advance(4 * time.Second)
+	restartClock := newTestClock()
+	restartClock.now = clock.Now()
+	restarted := newTestFileService(t, dir, restartClock)
+	restarted.ResumeGames()
+
+	// the turn keeps its deadline
+	game, err := restarted.GetGame(gameId)
+	require.NoError(t, err)
+	require.True(t, deadline.Equal(game.TurnDeadline()))
+	restartClock.Advance(5 * time.Second)
+	require.Empty(t, game.Moves)
+	restartClock.Advance(time.Second)
+
+	game, err = restarted.GetGame(gameId)
+	require.NoError(t, err)
+	require.Equal(t, []model.PlayerId{playerId}, game.Moves)
+	require.NotEqual(t, playerId, game.RoundPlayerId())
+	require.True(t, restartClock.Now().Add(10*time.Second).Equal(game.TurnDeadline()))
+}
+
+func TestResumeBot(t *testing.T) {
+
+	dir := t.TempDir()
+	clock := newTestClock()
+	service := newTestFileService(t, dir, clock)
+	service.SetBot(&testBot{})
+	service.SetBotDelay(-1)
+
+	user := newTestUser(1)
+	game, err := service.CreateGame(user, model.GameOptions{})
+	require.NoError(t, err)
+	humanId := model.NewPlayerId(game.Id(), user.Id())
+	_, err = service.AddBot(game.MustPlayer(humanId))
+	require.NoError(t, err)
+	game, err = service.StartGame(game)
+	require.NoError(t, err)
+	if game.IsPlayingPlayer(humanId) {
+		game, err = playTestMove(service, game.MustPlayer(humanId), game.Version())
+		require.NoError(t, err)
+	}
+	nbMove := len(game.Moves)
+
+	// the server restarts while the bot is to play
+	restarted := newTestFileService(t, dir, clock)
+	restarted.SetBot(&testBot{})
+	restarted.SetBotDelay(time.Second)
+	restarted.ResumeGames()
+	clock.Advance(time.Second)
+
+	game, err = restarted.GetGame(game.Id())
+	require.NoError(t, err)
+	require.Len(t, game.Moves, nbMove+1)
+	require.True(t, game.IsPlayingPlayer(humanId))
+}
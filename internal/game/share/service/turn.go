@@ -0,0 +1,194 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// turn timer
+
+const (
+	turnTickPeriod = time.Second
+)
+
+type turnTimer struct {
+	round int
+	stop  chan struct{}
+}
+
+// updateTurnTimer starts a new turn clock whenever a game with a turn timeout moves to a new round,
+// and stops it once the game is no longer started.
+// It is called with the game lock held, right before the game is stored.
+func (s *gameService[PlayerT, GameT]) updateTurnTimer(game GameT) {
+	if game.TurnTimeout() <= 0 || s.noTurnTimer {
+		return
+	}
+	if !game.IsStarted() {
+		s.stopTurnTimer(game.Id())
+		return
+	}
+
+	s.turnsMutex.Lock()
+	defer s.turnsMutex.Unlock()
+
+	timer, found := s.turns[game.Id()]
+	if found {
+		if timer.round == game.Round() {
+			return
+		}
+		close(timer.stop)
+	}
+
+	deadline := s.clock.Now().Add(game.TurnTimeout())
+	game.SetTurnDeadline(deadline)
+	s.startTurnTimer(game, deadline)
+}
+
+// resumeTurnTimer starts the turn clock of a game that has none yet, keeping the deadline of its round:
+// a turn that timed out in the meantime expires at the first tick.
+// It is called with the game lock held.
+func (s *gameService[PlayerT, GameT]) resumeTurnTimer(game GameT) {
+	if game.TurnTimeout() <= 0 || s.noTurnTimer || !game.IsStarted() {
+		return
+	}
+
+	s.turnsMutex.Lock()
+	defer s.turnsMutex.Unlock()
+
+	if _, found := s.turns[game.Id()]; found {
+		return
+	}
+	deadline := game.TurnDeadline()
+	if deadline.IsZero() {
+		deadline = s.clock.Now().Add(game.TurnTimeout())
+		game.SetTurnDeadline(deadline)
+	}
+	s.startTurnTimer(game, deadline)
+}
+
+// startTurnTimer is called with the turns mutex held.
+func (s *gameService[PlayerT, GameT]) startTurnTimer(game GameT, deadline time.Time) {
+	timer := &turnTimer{
+		round: game.Round(),
+		stop:  make(chan struct{}),
+	}
+	s.turns[game.Id()] = timer
+	s.scheduleTurnTick(game.Id(), timer, deadline)
+}
+
+func (s *gameService[PlayerT, GameT]) stopTurnTimer(gameId model.GameId) {
+	s.turnsMutex.Lock()
+	defer s.turnsMutex.Unlock()
+
+	if timer, found := s.turns[gameId]; found {
+		close(timer.stop)
+		delete(s.turns, gameId)
+	}
+}
+
+// scheduleTurnTick runs the next tick of the turn clock, until the deadline is reached or the timer is stopped.
+func (s *gameService[PlayerT, GameT]) scheduleTurnTick(gameId model.GameId, timer *turnTimer, deadline time.Time) {
+	s.clock.AfterFunc(turnTickPeriod, func() {
+		select {
+		case <-timer.stop:
+			return
+		default:
+		}
+		now := s.clock.Now()
+		if !now.Before(deadline) {
+			s.expireTurn(gameId, timer.round)
+			return
+		}
+		s.tickTurn(gameId, timer.round, deadline.Sub(now))
+		s.scheduleTurnTick(gameId, timer, deadline)
+	})
+}
+
+// tickTurn notifies the remaining time of the round while holding the game lock, unless the round moved on in the meantime.
+func (s *gameService[PlayerT, GameT]) tickTurn(gameId model.GameId, round int, remaining time.Duration) {
+
+	unlock := s.lockGame(gameId)
+	defer unlock()
+
+	game, err := s.gameStore.Get(gameId)
+	if err != nil {
+		return
+	}
+	if !game.IsStarted() || game.Round() != round {
+		return
+	}
+	s.onTurnTick(game, remaining)
+}
+
+// expireTurn plays the default action of the round player, or makes them forfeit, unless the round moved on in the meantime.
+func (s *gameService[PlayerT, GameT]) expireTurn(gameId model.GameId, round int) {
+
+	unlock := s.lockGame(gameId)
+	defer unlock()
+
+	game, err := s.gameStore.Get(gameId)
+	if err != nil {
+		return
+	}
+	if !game.IsStarted() || game.Round() != round || s.clock.Now().Before(game.TurnDeadline()) {
+		return
+	}
+
+	player := game.RoundPlayer()
+	s.logger.Info(fmt.Sprintf("[turn] game %s :: player %s :: round %d >>> TIMEOUT", game.Id(), player.Id(), round))
+
+	game, err = s.timeoutTurn(game, player)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("[turn] unable to time out player %s", player.Id()), zap.Error(err))
+		// forget the clock of the round, so that the next save of the game starts a new one
+		s.stopTurnTimer(gameId)
+		return
+	}
+	s.onEvent(game, model.EventType_TurnTimeout, player.Id(), nil)
+	if !game.IsStopped() {
+		// stopped games are already broadcast
+		s.onGame(game)
+	}
+}
+
+// timeoutTurn plays the default action of the player, or makes them forfeit when there is none.
+// A failing default action leaves the game untouched, so the player forfeits as well.
+// A forfeit stops the whole game, whatever the number of players: the other players win.
+func (s *gameService[PlayerT, GameT]) timeoutTurn(game GameT, player PlayerT) (GameT, error) {
+	stop, err := s.plugin.PlayDefault(game, player)
+	if err != nil && !errors.Is(err, model.ErrNoDefaultAction) {
+		s.logger.Error(fmt.Sprintf("[turn] player %s unable to play the default action, forfeit", player.Id()), zap.Error(err))
+		err = model.ErrNoDefaultAction
+	}
+	if errors.Is(err, model.ErrNoDefaultAction) {
+		// forfeit
+		game.SetLoosers(player.Id())
+		stop, err = true, nil
+	}
+	if err != nil {
+		return s.empty, err
+	}
+	if stop {
+		return s.stopGame(game)
+	}
+	return s.SaveGame(game)
+}
+
+// //////////////////////////////////////////////////
+// turn callbacks
+
+func (s *gameService[PlayerT, GameT]) RegisterOnTurnTick(onTurnFn func(game GameT, remaining time.Duration)) {
+	s.onTurnFns = append(s.onTurnFns, onTurnFn)
+}
+
+func (s *gameService[PlayerT, GameT]) onTurnTick(game GameT, remaining time.Duration) {
+	for _, onTurnFn := range s.onTurnFns {
+		onTurnFn(game, remaining)
+	}
+}
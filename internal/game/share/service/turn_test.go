@@ -0,0 +1,151 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestTurnTimeout(t *testing.T) {
+
+	type TestCase struct {
+		playDefaultFn func(game *testGame, player *model.BasePlayer) (bool, error)
+		wantForfeit   bool
+	}
+
+	testCases := map[string]TestCase{
+		"default-move": {},
+		"forfeit": {
+			playDefaultFn: func(game *testGame, player *model.BasePlayer) (bool, error) {
+				return false, model.ErrNoDefaultAction
+			},
+			wantForfeit: true,
+		},
+		"failing-default-move": {
+			playDefaultFn: func(game *testGame, player *model.BasePlayer) (bool, error) {
+				// checked before changing the game
+				return false, fmt.Errorf("boom")
+			},
+			wantForfeit: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+
+			service := newTestService(&testPlugin{playDefaultFn: tc.playDefaultFn})
+			clock := newTestClock()
+			service.SetClock(clock)
+			nbTick := 0
+			service.RegisterOnTurnTick(func(game *testGame, remaining time.Duration) {
+				nbTick++
+			})
+			var timeouts []model.Event
+			service.RegisterOnEvent(func(event model.Event) {
+				if event.Type == model.EventType_TurnTimeout {
+					timeouts = append(timeouts, event)
+				}
+			})
+
+			game := newStartedTestGame(t, service, model.GameOptions{TurnTimeout: 2 * time.Second})
+			gameId := game.Id()
+			playerId := game.RoundPlayerId()
+			require.Equal(t, clock.Now().Add(2*time.Second), game.TurnDeadline())
+
+			// the turn is still running right before the deadline
+			clock.Advance(1999 * time.Millisecond)
+			require.Empty(t, timeouts)
+			require.Equal(t, 1, nbTick)
+
+			// the timers run on the calling goroutine, so the timeout is done once the clock is advanced
+			clock.Advance(time.Millisecond)
+			require.Len(t, timeouts, 1)
+			require.Equal(t, playerId, timeouts[0].PlayerId)
+
+			game, err := service.GetGame(gameId)
+			require.NoError(t, err)
+			if tc.wantForfeit {
+				require.True(t, game.IsStopped())
+				require.True(t, game.MustPlayer(playerId).Result().IsLoose())
+				require.Empty(t, game.Moves)
+				require.NotContains(t, service.turns, gameId)
+				return
+			}
+			require.Equal(t, []model.PlayerId{playerId}, game.Moves)
+			require.NotEqual(t, playerId, game.RoundPlayerId())
+			require.Equal(t, clock.Now().Add(2*time.Second), game.TurnDeadline())
+		})
+	}
+}
+
+func TestTurnTimeoutForfeit(t *testing.T) {
+
+	plugin := &testPlugin{
+		playDefaultFn: func(game *testGame, player *model.BasePlayer) (bool, error) {
+			return false, model.ErrNoDefaultAction
+		},
+		maxNbPlayer: 3,
+	}
+	service := newTestService(plugin)
+	clock := newTestClock()
+	service.SetClock(clock)
+
+	game, err := service.CreateGame(newTestUser(1), model.GameOptions{TurnTimeout: 2 * time.Second})
+	require.NoError(t, err)
+	for index := 2; index <= 3; index++ {
+		_, err = service.JoinGame(game, newTestUser(index))
+		require.NoError(t, err)
+	}
+	game, err = service.StartGame(game)
+	require.NoError(t, err)
+	gameId := game.Id()
+	playerId := game.RoundPlayerId()
+
+	// a forfeit stops the whole game, even with players left to play
+	clock.Advance(2 * time.Second)
+	game, err = service.GetGame(gameId)
+	require.NoError(t, err)
+	require.True(t, game.IsStopped())
+	require.NotContains(t, service.turns, gameId)
+	require.Equal(t, 3, game.NbPlayer())
+	for _, player := range game.Players() {
+		if player.Id() == playerId {
+			require.True(t, player.Result().IsLoose())
+		} else {
+			require.True(t, player.Result().IsWin())
+		}
+	}
+}
+
+func TestTurnTimeoutFailure(t *testing.T) {
+
+	plugin := &testPlugin{
+		playDefaultFn: func(game *testGame, player *model.BasePlayer) (bool, error) {
+			return false, fmt.Errorf("boom")
+		},
+		canStopErr: fmt.Errorf("boom"),
+	}
+	service := newTestService(plugin)
+	clock := newTestClock()
+	service.SetClock(clock)
+
+	game := newStartedTestGame(t, service, model.GameOptions{TurnTimeout: 2 * time.Second})
+	gameId := game.Id()
+
+	// the player can neither play by default nor forfeit: the clock of the round is dropped
+	clock.Advance(2 * time.Second)
+	require.NotContains(t, service.turns, gameId)
+	game, err := service.GetGame(gameId)
+	require.NoError(t, err)
+	require.True(t, game.IsStarted())
+
+	// so that the next round gets a clock of its own
+	game, err = playTestMove(service, game.RoundPlayer(), game.Version())
+	require.NoError(t, err)
+	require.Contains(t, service.turns, gameId)
+	require.Equal(t, clock.Now().Add(2*time.Second), game.TurnDeadline())
+}
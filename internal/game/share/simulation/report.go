@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// report
+
+// Report sums up simulated games.
+type Report struct {
+	NbGame     int
+	NbFinished int
+	// NbAction is the number of actions of finished games.
+	NbAction int
+	// Seats count the results of the players by seat, the first seat being the creator of the game.
+	Seats    []SeatReport
+	Outcomes map[string]int
+	Failures []Failure
+}
+
+// SeatReport counts the results of a seat over finished games.
+type SeatReport struct {
+	NbWin   int
+	NbTie   int
+	NbLoose int
+}
+
+// Failure is a simulated game gone wrong: an invariant broken, an action rejected, a panic...
+type Failure struct {
+	GameIndex int
+	GameId    model.GameId
+	// Seed of the game random generator, to reproduce the game.
+	Seed     int64
+	NbAction int
+	// Check is the invariant broken or the step that failed.
+	Check string
+	Err   error
+	Panic bool
+	Stack string
+}
+
+func NewReport() Report {
+	return Report{
+		Outcomes: make(map[string]int),
+	}
+}
+
+func (r *Report) add(result gameResult) {
+	r.NbGame++
+	if result.failure != nil {
+		r.Failures = append(r.Failures, *result.failure)
+	}
+	if !result.stopped {
+		return
+	}
+
+	r.NbFinished++
+	r.NbAction += result.nbAction
+	for seat, playerResult := range result.results {
+		for len(r.Seats) <= seat {
+			r.Seats = append(r.Seats, SeatReport{})
+		}
+		switch {
+		case playerResult.IsWin():
+			r.Seats[seat].NbWin++
+		case playerResult.IsTie():
+			r.Seats[seat].NbTie++
+		case playerResult.IsLoose():
+			r.Seats[seat].NbLoose++
+		}
+	}
+	if result.outcome != "" {
+		r.Outcomes[result.outcome]++
+	}
+}
+
+func (r Report) HasFailures() bool {
+	return len(r.Failures) > 0
+}
+
+func (r Report) NbPanic() int {
+	nbPanic := 0
+	for _, failure := range r.Failures {
+		if failure.Panic {
+			nbPanic++
+		}
+	}
+	return nbPanic
+}
+
+// MeanLength is the mean number of actions of finished games.
+func (r Report) MeanLength() float64 {
+	return ratio(r.NbAction, r.NbFinished)
+}
+
+// WinRate is the rate of finished games won by the given seat ( starting at zero ).
+func (r Report) WinRate(seat int) float64 {
+	if seat < 0 || len(r.Seats) <= seat {
+		return 0
+	}
+	return ratio(r.Seats[seat].NbWin, r.NbFinished)
+}
+
+func (r Report) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("games     %d ( %d finished )\n", r.NbGame, r.NbFinished))
+	sb.WriteString(fmt.Sprintf("failures  %d ( %d panics )\n", len(r.Failures), r.NbPanic()))
+	sb.WriteString(fmt.Sprintf("length    %.1f actions on average\n", r.MeanLength()))
+	for seat, seatReport := range r.Seats {
+		sb.WriteString(fmt.Sprintf("seat %d    win %5.1f%% | tie %5.1f%% | loose %5.1f%%\n", seat+1,
+			100*ratio(seatReport.NbWin, r.NbFinished),
+			100*ratio(seatReport.NbTie, r.NbFinished),
+			100*ratio(seatReport.NbLoose, r.NbFinished)))
+	}
+	if len(r.Outcomes) > 0 {
+		outcomes := make([]string, 0, len(r.Outcomes))
+		for outcome := range r.Outcomes {
+			outcomes = append(outcomes, outcome)
+		}
+		sort.Strings(outcomes)
+		for index, outcome := range outcomes {
+			outcomes[index] = fmt.Sprintf("%s %.1f%%", outcome, 100*ratio(r.Outcomes[outcome], r.NbFinished))
+		}
+		sb.WriteString(fmt.Sprintf("outcomes  %s\n", strings.Join(outcomes, " | ")))
+	}
+	return sb.String()
+}
+
+func (f Failure) Error() string {
+	return fmt.Sprintf("game #%d %s ( seed %d ) :: action %d :: %s :: %s", f.GameIndex+1, f.GameId, f.Seed, f.NbAction+1, f.Check, f.Err)
+}
+
+func ratio(value, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(value) / float64(total)
+}
@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"github.com/gre-ory/games-go/internal/game/share/model"
+	"github.com/gre-ory/games-go/internal/game/share/service"
+)
+
+// //////////////////////////////////////////////////
+// script
+
+// NewScript returns a strategy playing the given actions in order.
+// It fails with ErrEndOfScript once all actions are played.
+func NewScript[PlayerT model.Player, GameT model.Game[PlayerT]](actions ...service.BotAction[PlayerT, GameT]) service.Bot[PlayerT, GameT] {
+	return &script[PlayerT, GameT]{
+		actions: actions,
+	}
+}
+
+type script[PlayerT model.Player, GameT model.Game[PlayerT]] struct {
+	actions []service.BotAction[PlayerT, GameT]
+}
+
+func (s *script[PlayerT, GameT]) NextAction(game GameT, player PlayerT) (service.BotAction[PlayerT, GameT], error) {
+	if len(s.actions) == 0 {
+		return service.BotAction[PlayerT, GameT]{}, ErrEndOfScript
+	}
+	action := s.actions[0]
+	s.actions = s.actions[1:]
+	return action, nil
+}
+
+// //////////////////////////////////////////////////
+// players
+
+// NewPlayers gives each of the given number of seats its own strategy.
+func NewPlayers[PlayerT model.Player, GameT model.Game[PlayerT]](nbPlayer int, newStrategy func() service.Bot[PlayerT, GameT]) func() []service.Bot[PlayerT, GameT] {
+	return func() []service.Bot[PlayerT, GameT] {
+		strategies := make([]service.Bot[PlayerT, GameT], 0, nbPlayer)
+		for seat := 0; seat < nbPlayer; seat++ {
+			strategies = append(strategies, newStrategy())
+		}
+		return strategies
+	}
+}
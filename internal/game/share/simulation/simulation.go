@@ -0,0 +1,275 @@
+package simulation
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+	"github.com/gre-ory/games-go/internal/game/share/service"
+)
+
+const (
+	DefaultNbGame      = 1000
+	DefaultMaxNbAction = 10000
+)
+
+var (
+	ErrMissingPlayers  = fmt.Errorf("missing simulated players")
+	ErrEndlessGame     = fmt.Errorf("game did not end")
+	ErrNoPlayingPlayer = fmt.Errorf("no player is playing")
+	ErrMissingResult   = fmt.Errorf("player without result")
+	ErrEndOfScript     = fmt.Errorf("end of script")
+)
+
+// //////////////////////////////////////////////////
+// config
+
+// Config describes the games to simulate.
+// Simulated players are regular users: their actions go through GameService.Play as the actions of a human would.
+type Config[PlayerT model.Player, GameT model.Game[PlayerT]] struct {
+	// NbGame is the number of games to simulate ( zero means DefaultNbGame ).
+	NbGame int
+	// NbWorker is the number of games simulated at the same time ( zero means one per cpu ).
+	NbWorker int
+	// Options of the simulated games.
+	Options model.GameOptions
+	// Settings specific to the simulated game ( nil means the game defaults ).
+	Settings any
+	// NewPlayers gives the strategy of each seat of a new game: the game has as many players as strategies.
+	// Bots of the games are strategies, and so are scripts.
+	NewPlayers func() []service.Bot[PlayerT, GameT]
+	// MaxNbAction is the number of actions after which a game is reported as endless ( zero means DefaultMaxNbAction ).
+	MaxNbAction int
+	// Invariants are checked once the game is started and after each action, on top of the default ones.
+	Invariants []Invariant[PlayerT, GameT]
+	// Outcome labels the end of a game, to count outcomes beyond the results of the players ( optional ).
+	Outcome func(game GameT) string
+}
+
+// //////////////////////////////////////////////////
+// invariant
+
+// Invariant is a rule the game must keep all along.
+type Invariant[PlayerT model.Player, GameT model.Game[PlayerT]] struct {
+	Name    string
+	CheckFn func(game GameT) error
+}
+
+// DefaultInvariants hold for every game: someone has to play a running game, and everyone gets a result at the end.
+func DefaultInvariants[PlayerT model.Player, GameT model.Game[PlayerT]]() []Invariant[PlayerT, GameT] {
+	return []Invariant[PlayerT, GameT]{
+		{
+			Name: "playing",
+			CheckFn: func(game GameT) error {
+				if game.IsStarted() && len(game.PlayingPlayers()) == 0 {
+					return ErrNoPlayingPlayer
+				}
+				return nil
+			},
+		},
+		{
+			Name: "results",
+			CheckFn: func(game GameT) error {
+				if !game.IsStopped() {
+					return nil
+				}
+				for _, player := range game.Players() {
+					if !player.HasResult() {
+						return fmt.Errorf("%w: %s", ErrMissingResult, player.Id())
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// //////////////////////////////////////////////////
+// run
+
+// Run simulates games on the given service and reports how they went.
+// Bots of the service are disabled: all players are driven by the simulation.
+func Run[PlayerT model.Player, GameT model.Game[PlayerT]](gameService service.GameService[PlayerT, GameT], config Config[PlayerT, GameT]) Report {
+	if config.NbGame <= 0 {
+		config.NbGame = DefaultNbGame
+	}
+	if config.NbWorker <= 0 {
+		config.NbWorker = runtime.NumCPU()
+	}
+	if config.MaxNbAction <= 0 {
+		config.MaxNbAction = DefaultMaxNbAction
+	}
+	config.Invariants = append(DefaultInvariants[PlayerT, GameT](), config.Invariants...)
+	gameService.SetBotDelay(-1)
+
+	s := &simulation[PlayerT, GameT]{
+		service: gameService,
+		config:  config,
+	}
+
+	//
+	// simulate games in parallel, then report them in order
+	//
+
+	results := make([]gameResult, config.NbGame)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < config.NbWorker; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				results[index] = s.runGame(index)
+			}
+		}()
+	}
+	for index := 0; index < config.NbGame; index++ {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	report := NewReport()
+	for _, result := range results {
+		report.add(result)
+	}
+	return report
+}
+
+type simulation[PlayerT model.Player, GameT model.Game[PlayerT]] struct {
+	service service.GameService[PlayerT, GameT]
+	config  Config[PlayerT, GameT]
+}
+
+// gameResult is what a single simulated game contributes to the report.
+type gameResult struct {
+	nbAction int
+	stopped  bool
+	results  []model.PlayerResult
+	outcome  string
+	failure  *Failure
+}
+
+func (s *simulation[PlayerT, GameT]) runGame(index int) (result gameResult) {
+	var game GameT
+	var gameId model.GameId
+	var seed int64
+	fail := func(check string, err error) gameResult {
+		result.failure = &Failure{
+			GameIndex: index,
+			GameId:    gameId,
+			Seed:      seed,
+			NbAction:  result.nbAction,
+			Check:     check,
+			Err:       err,
+		}
+		return result
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			fail("panic", fmt.Errorf("%v", r))
+			result.failure.Panic = true
+			result.failure.Stack = string(debug.Stack())
+		}
+	}()
+
+	strategies := s.config.NewPlayers()
+	if len(strategies) == 0 {
+		return fail("setup", ErrMissingPlayers)
+	}
+
+	//
+	// create users, join & start
+	//
+
+	playerIds := make([]model.PlayerId, 0, len(strategies))
+	var err error
+	for seat := range strategies {
+		user := model.NewUser(model.NewUserId())
+		user.SetName(model.UserName(fmt.Sprintf("Player %d", seat+1)))
+		if seat == 0 {
+			game, err = s.service.CreateGameWithSettings(user, s.config.Options, s.config.Settings)
+			if err == nil {
+				gameId, seed = game.Id(), game.Seed()
+			}
+		} else {
+			game, err = s.service.JoinGame(game, user)
+		}
+		if err != nil {
+			return fail("join", err)
+		}
+		playerIds = append(playerIds, model.NewPlayerId(game.Id(), user.Id()))
+	}
+	game, err = s.service.StartGame(game)
+	if err != nil {
+		return fail("start", err)
+	}
+	if check, err := s.checkInvariants(game); err != nil {
+		return fail(check, err)
+	}
+
+	//
+	// play until the end
+	//
+
+	for !game.IsStopped() {
+		if result.nbAction >= s.config.MaxNbAction {
+			return fail("length", ErrEndlessGame)
+		}
+		seat, player, found := playingSeat(game, playerIds)
+		if !found {
+			return fail("playing", ErrNoPlayingPlayer)
+		}
+		action, err := strategies[seat].NextAction(game, player)
+		if err != nil {
+			return fail(fmt.Sprintf("seat %d", seat+1), err)
+		}
+		game, err = s.service.Play(player, game.Version(), action.EventType, action.Payload, action.PlayFn)
+		if err != nil {
+			return fail(fmt.Sprintf("seat %d :: %s", seat+1, action.EventType), err)
+		}
+		result.nbAction++
+		if check, err := s.checkInvariants(game); err != nil {
+			return fail(check, err)
+		}
+	}
+
+	//
+	// collect results
+	//
+
+	result.stopped = true
+	for _, playerId := range playerIds {
+		result.results = append(result.results, game.MustPlayer(playerId).Result())
+	}
+	if s.config.Outcome != nil {
+		result.outcome = s.config.Outcome(game)
+	}
+
+	// finished games are of no use anymore
+	_ = s.service.DeleteGame(game, playerIds[0])
+
+	return result
+}
+
+func (s *simulation[PlayerT, GameT]) checkInvariants(game GameT) (string, error) {
+	for _, invariant := range s.config.Invariants {
+		if err := invariant.CheckFn(game); err != nil {
+			return invariant.Name, err
+		}
+	}
+	return "", nil
+}
+
+// playingSeat returns the first playing player in seat order.
+func playingSeat[PlayerT model.Player, GameT model.Game[PlayerT]](game GameT, playerIds []model.PlayerId) (int, PlayerT, bool) {
+	for seat, playerId := range playerIds {
+		if player, found := game.Player(playerId); found && player.Status().IsPlaying() {
+			return seat, player, true
+		}
+	}
+	var empty PlayerT
+	return 0, empty, false
+}
@@ -0,0 +1,126 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// event store
+
+type EventStore interface {
+	Append(event model.Event) error
+	// List returns the events of the game in the order they were appended.
+	List(gameId model.GameId) ([]model.Event, error)
+}
+
+// //////////////////////////////////////////////////
+// event memory store
+
+func NewEventMemoryStore() EventStore {
+	return &eventMemoryStore{
+		events: map[model.GameId][]model.Event{},
+	}
+}
+
+type eventMemoryStore struct {
+	events map[model.GameId][]model.Event
+	mutex  sync.RWMutex
+}
+
+func (s *eventMemoryStore) Append(event model.Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.events[event.GameId] = append(s.events[event.GameId], event)
+	return nil
+}
+
+func (s *eventMemoryStore) List(gameId model.GameId) ([]model.Event, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	events, found := s.events[gameId]
+	if !found {
+		return nil, model.ErrGameNotFound
+	}
+	return append([]model.Event(nil), events...), nil
+}
+
+// //////////////////////////////////////////////////
+// event file store
+
+const (
+	eventFileExtension = ".jsonl"
+)
+
+// NewEventFileStore appends the events of each game as json lines to one file per game in dir.
+func NewEventFileStore(dir string) (EventStore, error) {
+	if dir == "" {
+		return nil, model.ErrMissingStoreDir
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &eventFileStore{
+		dir: dir,
+	}, nil
+}
+
+type eventFileStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+func (s *eventFileStore) Append(event model.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	file, err := os.OpenFile(s.path(event.GameId), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (s *eventFileStore) List(gameId model.GameId) ([]model.Event, error) {
+	s.mutex.Lock()
+	data, err := os.ReadFile(s.path(gameId))
+	s.mutex.Unlock()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, model.ErrGameNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	events := make([]model.Event, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var event model.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+func (s *eventFileStore) path(gameId model.GameId) string {
+	return filepath.Join(s.dir, string(gameId)+eventFileExtension)
+}
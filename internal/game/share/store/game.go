@@ -1,6 +1,10 @@
 package store
 
-import "github.com/gre-ory/games-go/internal/game/share/model"
+import (
+	"sync"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
 
 // //////////////////////////////////////////////////
 // game store
@@ -8,10 +12,13 @@ import "github.com/gre-ory/games-go/internal/game/share/model"
 type GameStorable interface {
 	Id() model.GameId
 	Status() model.GameStatus
+	Version() int
+	SetVersion(version int)
 }
 
 type GameStore[GameT GameStorable] interface {
 	ListStatus(status model.GameStatus) []GameT
+	// Set stores the game only if its version is the stored one ( compare-and-swap ) and bumps it.
 	Set(game GameT) error
 	Get(id model.GameId) (GameT, error)
 	Delete(id model.GameId) error
@@ -22,19 +29,26 @@ type GameStore[GameT GameStorable] interface {
 
 func NewGameMemoryStore[GameT GameStorable]() GameStore[GameT] {
 	return &gameMemoryStore[GameT]{
-		games: map[model.GameId]GameT{},
+		games:    map[model.GameId]GameT{},
+		statuses: map[model.GameId]model.GameStatus{},
+		versions: map[model.GameId]int{},
 	}
 }
 
 type gameMemoryStore[GameT GameStorable] struct {
-	games map[model.GameId]GameT
-	empty GameT
+	games    map[model.GameId]GameT
+	statuses map[model.GameId]model.GameStatus // status as of the last set
+	versions map[model.GameId]int              // version as of the last set
+	mutex    sync.RWMutex
+	empty    GameT
 }
 
 func (s *gameMemoryStore[GameT]) ListStatus(status model.GameStatus) []GameT {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 	filtered := make([]GameT, 0, len(s.games))
-	for _, game := range s.games {
-		if game.Status() == status {
+	for id, game := range s.games {
+		if s.statuses[id] == status {
 			filtered = append(filtered, game)
 		}
 	}
@@ -42,11 +56,32 @@ func (s *gameMemoryStore[GameT]) ListStatus(status model.GameStatus) []GameT {
 }
 
 func (s *gameMemoryStore[GameT]) Set(game GameT) error {
-	s.games[game.Id()] = game
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.swapVersion(game); err != nil {
+		return err
+	}
+	s.set(game)
+	return nil
+}
+
+func (s *gameMemoryStore[GameT]) swapVersion(game GameT) error {
+	if s.versions[game.Id()] != game.Version() {
+		return model.ErrStaleGame
+	}
+	game.SetVersion(game.Version() + 1)
 	return nil
 }
 
+func (s *gameMemoryStore[GameT]) set(game GameT) {
+	s.games[game.Id()] = game
+	s.statuses[game.Id()] = game.Status()
+	s.versions[game.Id()] = game.Version()
+}
+
 func (s *gameMemoryStore[GameT]) Get(id model.GameId) (GameT, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 	if game, ok := s.games[id]; ok {
 		return game, nil
 	}
@@ -54,8 +89,12 @@ func (s *gameMemoryStore[GameT]) Get(id model.GameId) (GameT, error) {
 }
 
 func (s *gameMemoryStore[GameT]) Delete(id model.GameId) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 	if _, ok := s.games[id]; ok {
 		delete(s.games, id)
+		delete(s.statuses, id)
+		delete(s.versions, id)
 		return nil
 	}
 	return model.ErrGameNotFound
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// game codec
+
+// GameCodec is the serialization contract each game type implements
+// so its games can be persisted and reloaded by a durable store.
+type GameCodec[GameT GameStorable] interface {
+	Encode(game GameT) ([]byte, error)
+	Decode(data []byte) (GameT, error)
+}
+
+// //////////////////////////////////////////////////
+// game file store
+
+const (
+	gameFileExtension = ".json"
+)
+
+// NewGameFileStore keeps games in memory and writes every change to one file per game in dir.
+// Games already present in dir are loaded at construction.
+func NewGameFileStore[GameT GameStorable](logger *zap.Logger, dir string, codec GameCodec[GameT]) (GameStore[GameT], error) {
+	if dir == "" {
+		return nil, model.ErrMissingStoreDir
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &gameFileStore[GameT]{
+		gameMemoryStore: gameMemoryStore[GameT]{
+			games:    map[model.GameId]GameT{},
+			statuses: map[model.GameId]model.GameStatus{},
+			versions: map[model.GameId]int{},
+		},
+		logger: logger,
+		dir:    dir,
+		codec:  codec,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type gameFileStore[GameT GameStorable] struct {
+	gameMemoryStore[GameT]
+	logger *zap.Logger
+	dir    string
+	codec  GameCodec[GameT]
+}
+
+func (s *gameFileStore[GameT]) Set(game GameT) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.swapVersion(game); err != nil {
+		return err
+	}
+	data, err := s.codec.Encode(game)
+	if err == nil {
+		err = s.write(game.Id(), data)
+	}
+	if err != nil {
+		game.SetVersion(game.Version() - 1)
+		return err
+	}
+	s.set(game)
+	return nil
+}
+
+func (s *gameFileStore[GameT]) Delete(id model.GameId) error {
+	if err := s.gameMemoryStore.Delete(id); err != nil {
+		return err
+	}
+	if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *gameFileStore[GameT]) load() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), gameFileExtension) {
+			continue
+		}
+		path := filepath.Join(s.dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		game, err := s.codec.Decode(data)
+		if err != nil {
+			s.logger.Error(fmt.Sprintf("[store] unable to decode game file %s >>> SKIPPED", path), zap.Error(err))
+			continue
+		}
+		s.logger.Info(fmt.Sprintf("[store] game %s loaded ( %s )", game.Id(), game.Status()))
+		s.set(game)
+	}
+	return nil
+}
+
+func (s *gameFileStore[GameT]) write(id model.GameId, data []byte) error {
+	tmp, err := os.CreateTemp(s.dir, string(id)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(id))
+}
+
+func (s *gameFileStore[GameT]) path(id model.GameId) string {
+	return filepath.Join(s.dir, string(id)+gameFileExtension)
+}
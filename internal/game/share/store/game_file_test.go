@@ -0,0 +1,177 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestGameFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewGameFileStore(zap.NewNop(), dir, &testCodec{})
+	require.NoError(t, err)
+
+	g1 := &testGame{GameId: "G1", GameStatus: model.GameStatus_JoinableNotStartable}
+	g2 := &testGame{GameId: "G2", GameStatus: model.GameStatus_JoinableNotStartable}
+	g3 := &testGame{GameId: "G3", GameStatus: model.GameStatus_JoinableNotStartable}
+	require.NoError(t, store.Set(g1))
+	require.NoError(t, store.Set(g2))
+	require.NoError(t, store.Set(g3))
+	g1.GameStatus = model.GameStatus_Started
+	require.NoError(t, store.Set(g1))
+	require.NoError(t, store.Delete(g3.Id()))
+
+	// one file per game, written through a temp file renamed in place
+	require.Equal(t, []string{"G1.json", "G2.json"}, listFiles(t, dir))
+
+	// a stale write is refused and leaves the file untouched
+	stale := &testGame{GameId: "G1", GameStatus: model.GameStatus_Stopped, GameVersion: 1}
+	require.ErrorIs(t, store.Set(stale), model.ErrStaleGame)
+	require.Equal(t, 1, stale.Version())
+
+	// games are reloaded at startup
+	reloaded, err := NewGameFileStore(zap.NewNop(), dir, &testCodec{})
+	require.NoError(t, err)
+
+	game, err := reloaded.Get("G1")
+	require.NoError(t, err)
+	require.Equal(t, &testGame{GameId: "G1", GameStatus: model.GameStatus_Started, GameVersion: 2}, game)
+	game, err = reloaded.Get("G2")
+	require.NoError(t, err)
+	require.Equal(t, &testGame{GameId: "G2", GameStatus: model.GameStatus_JoinableNotStartable, GameVersion: 1}, game)
+	_, err = reloaded.Get("G3")
+	require.ErrorIs(t, err, model.ErrGameNotFound)
+	require.Len(t, reloaded.ListStatus(model.GameStatus_Started), 1)
+	require.Len(t, reloaded.ListStatus(model.GameStatus_JoinableNotStartable), 1)
+
+	// reloaded games go on with their version
+	game.GameStatus = model.GameStatus_Started
+	require.NoError(t, reloaded.Set(game))
+	require.Equal(t, 2, game.Version())
+}
+
+func TestGameFileStoreWriteFailure(t *testing.T) {
+	type TestCase struct {
+		breakFn  func(dir string, codec *testCodec)
+		repairFn func(dir string, codec *testCodec)
+	}
+
+	tests := map[string]TestCase{
+		"encode error": {
+			breakFn:  func(dir string, codec *testCodec) { codec.encodeErr = fmt.Errorf("boom") },
+			repairFn: func(dir string, codec *testCodec) { codec.encodeErr = nil },
+		},
+		"missing directory": {
+			breakFn:  func(dir string, codec *testCodec) { require.NoError(t, os.RemoveAll(dir)) },
+			repairFn: func(dir string, codec *testCodec) { require.NoError(t, os.MkdirAll(dir, 0o755)) },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "games")
+			codec := &testCodec{}
+			store, err := NewGameFileStore(zap.NewNop(), dir, codec)
+			require.NoError(t, err)
+
+			game := &testGame{GameId: "G1", GameStatus: model.GameStatus_JoinableNotStartable}
+			require.NoError(t, store.Set(game))
+			require.Equal(t, 1, game.Version())
+
+			tc.breakFn(dir, codec)
+			game.GameStatus = model.GameStatus_Started
+			require.Error(t, store.Set(game))
+
+			// the version is rolled back so the game can be saved again
+			require.Equal(t, 1, game.Version())
+
+			tc.repairFn(dir, codec)
+			require.NoError(t, store.Set(game))
+			require.Equal(t, 2, game.Version())
+			require.Equal(t, []string{"G1.json"}, listFiles(t, dir))
+		})
+	}
+}
+
+func TestGameFileStoreSkipCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "G1.json", `{"id":"G1","status":3,"version":4}`)
+	writeFile(t, dir, "G2.json", `{not json`)
+	writeFile(t, dir, "G3.json", `{"id":""}`)
+	writeFile(t, dir, "G4.json.123.tmp", `{"id":"G4","status":3,"version":1}`)
+	writeFile(t, dir, "README", `{"id":"G5","status":3,"version":1}`)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "G6.json"), 0o755))
+
+	store, err := NewGameFileStore(zap.NewNop(), dir, &testCodec{})
+	require.NoError(t, err)
+
+	game, err := store.Get("G1")
+	require.NoError(t, err)
+	require.Equal(t, 4, game.Version())
+	for _, id := range []model.GameId{"G2", "G3", "G4", "G5", "G6"} {
+		_, err := store.Get(id)
+		require.ErrorIs(t, err, model.ErrGameNotFound, string(id))
+	}
+}
+
+func TestGameFileStoreMissingDir(t *testing.T) {
+	_, err := NewGameFileStore(zap.NewNop(), "", &testCodec{})
+	require.ErrorIs(t, err, model.ErrMissingStoreDir)
+}
+
+// //////////////////////////////////////////////////
+// test game
+
+type testGame struct {
+	GameId      model.GameId     `json:"id"`
+	GameStatus  model.GameStatus `json:"status"`
+	GameVersion int              `json:"version"`
+}
+
+func (g *testGame) Id() model.GameId         { return g.GameId }
+func (g *testGame) Status() model.GameStatus { return g.GameStatus }
+func (g *testGame) Version() int             { return g.GameVersion }
+func (g *testGame) SetVersion(version int)   { g.GameVersion = version }
+
+type testCodec struct {
+	encodeErr error
+}
+
+func (c *testCodec) Encode(game *testGame) ([]byte, error) {
+	if c.encodeErr != nil {
+		return nil, c.encodeErr
+	}
+	return json.Marshal(game)
+}
+
+func (c *testCodec) Decode(data []byte) (*testGame, error) {
+	game := &testGame{}
+	if err := json.Unmarshal(data, game); err != nil {
+		return nil, err
+	}
+	if game.GameId == "" {
+		return nil, model.ErrMissingGameId
+	}
+	return game, nil
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func listFiles(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
@@ -50,6 +50,10 @@ func (s *hubServer[PlayerT, GameT]) HtmxConnect(w http.ResponseWriter, r *http.R
 			}
 			s.logger.Info(fmt.Sprintf("[api] user %s not found >>> create a new one", userId))
 			user = s.newUserFromCookieFn(cookie)
+			if game, err := s.service.GetUserGame(userId); err == nil {
+				s.logger.Info(fmt.Sprintf("[api] user %s >>> re-attached to game %s", userId, game.Id()))
+				user.SetGameId(game.Id())
+			}
 			s.RegisterUser(user)
 		} else {
 			s.logger.Info(fmt.Sprintf("[api] user %s already exists", userId), zap.Any("user", user))
@@ -96,6 +100,15 @@ func (s *hubServer[PlayerT, GameT]) HtmxConnect(w http.ResponseWriter, r *http.R
 			break
 		}
 
+		//
+		// reconnect player ( if within the grace window )
+		//
+
+		game, err = s.service.ReconnectPlayer(playerId)
+		if err != nil {
+			break
+		}
+
 		s.logger.Info(fmt.Sprintf("[api] player %s >>> broadcasting game layout...", playerId))
 		s.BroadcastGameLayoutToPlayer(playerId, game)
 
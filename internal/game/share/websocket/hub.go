@@ -25,6 +25,7 @@ type Hub[PlayerT Player] interface {
 	GetInactiveUsers() []User
 	GetNotPlayingUsers() []User
 	GetPlayingUsers() []User
+	GetGameSpectators(gameId model.GameId) []User
 	FilterUsers(filterFn func(user User) bool) []User
 	RegisterUser(user User)
 	UnregisterUserId(id model.UserId)
@@ -47,6 +48,7 @@ type Hub[PlayerT Player] interface {
 	BroadcastToNotPlayingUsersFn(name string, acceptFn func(user User) (bool, model.Data))
 	BroadcastToPlayingUsers(name string, data model.Data)
 	BroadcastToPlayingUsersFn(name string, acceptFn func(user User) (bool, model.Data))
+	BroadcastToGameSpectators(name string, gameId model.GameId, data model.Data)
 	WrapUserData(data model.Data, user User) (bool, model.Data)
 
 	BroadcastToPlayer(name string, id model.PlayerId, data model.Data)
@@ -151,6 +153,12 @@ func (h *hub[PlayerT]) GetPlayingUsers() []User {
 	return h.FilterUsers(User.IsPlaying)
 }
 
+func (h *hub[PlayerT]) GetGameSpectators(gameId model.GameId) []User {
+	return h.FilterUsers(func(user User) bool {
+		return user.IsActive() && user.SpectatedGameId() == gameId
+	})
+}
+
 func (h *hub[PlayerT]) FilterUsers(filterFn func(user User) bool) []User {
 	unlock := h.rlock("FilterUsers")
 	defer unlock()
@@ -378,6 +386,30 @@ func (h *hub[PlayerT]) AcceptPlayingUsersFn(acceptFn func(user User) (bool, mode
 	}
 }
 
+func (h *hub[PlayerT]) BroadcastToGameSpectators(name string, gameId model.GameId, data model.Data) {
+	if DebugBroadcast {
+		h.logger.Info(fmt.Sprintf("[broadcast] user <<< tpl %s - game %v spectators", name, gameId))
+	}
+	h.broadcastUser <- h.NewNamedUserTemplate(
+		name,
+		h.AcceptGameSpectatorsFn(gameId, func(user User) (bool, model.Data) {
+			return h.WrapUserData(data.With("Spectator", true), user)
+		}),
+	)
+}
+
+func (h *hub[PlayerT]) AcceptGameSpectatorsFn(gameId model.GameId, acceptFn func(user User) (bool, model.Data)) func(user User) (bool, model.Data) {
+	return func(user User) (bool, model.Data) {
+		if user.SpectatedGameId() == gameId {
+			if acceptFn != nil {
+				return acceptFn(user)
+			}
+			return true, nil
+		}
+		return false, nil
+	}
+}
+
 func (h *hub[PlayerT]) NewNamedUserTemplate(name string, acceptFn func(user User) (bool, model.Data)) TplRenderer[User] {
 	return h.NewTplUserRenderer(acceptFn, h.NewNamedRenderFn(name))
 }
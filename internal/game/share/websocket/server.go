@@ -1,9 +1,11 @@
 package websocket
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -24,18 +26,28 @@ type HubServer[PlayerT Player, GameT Game[PlayerT]] interface {
 
 	UpdateUserFromPlayer(player PlayerT)
 	OnUserUpdate(userId model.UserId)
+	OnUserClose(userId model.UserId)
+
+	HandleSpectateGame(gameId model.GameId, user User) error
+	HandleStopSpectating(user User) error
 
 	GetPlayer(playerId model.PlayerId) (PlayerT, error)
 
+	GameActivity(game GameT) time.Time
+	IsAtTable(game GameT, player PlayerT) bool
+
 	BroadcastInfoToUser(userId model.UserId, info string)
+	BroadcastLocInfoToUser(userId model.UserId, locKey string)
 	BroadcastErrorToUser(userId model.UserId, err error)
 	BroadcastInfoToPlayers(game GameT, info string)
+	BroadcastLocInfoToPlayers(game GameT, locKey string)
 	BroadcastJoinableGamesToUser(userId model.UserId)
 	BroadcastJoinableGames()
 	BroadcastGameLayoutToPlayer(playerId model.PlayerId, game GameT)
 	BroadcastGame(game GameT)
 	BroadcastPlayers(game GameT)
 	BroadcastBoard(game GameT)
+	BroadcastCountdown(game GameT, remaining time.Duration)
 	BroadcastPlayer(player PlayerT)
 	BroadcastCookie(cookie *model.Cookie)
 	BroadcastUserCookie(cookie *model.Cookie, renderUserFn func(cookie *model.Cookie) func(w io.Writer, data model.Data))
@@ -43,10 +55,14 @@ type HubServer[PlayerT Player, GameT Game[PlayerT]] interface {
 	OnJoinGame(game GameT, player PlayerT)
 	OnGame(game GameT)
 	OnLeaveGame(game GameT, userId model.UserId)
+	OnKickPlayer(game GameT, userId model.UserId)
+	OnTurnTick(game GameT, remaining time.Duration)
 }
 
 type Game[PlayerT Player] interface {
 	Id() model.GameId
+	WasStarted() bool
+	IsPrivate() bool
 	Player(id model.PlayerId) (PlayerT, bool)
 	Players() []PlayerT
 }
@@ -68,6 +84,10 @@ func NewHubServer[PlayerT Player, GameT Game[PlayerT]](logger *zap.Logger, hub H
 	service.RegisterOnJoinGame(server.OnJoinGame)
 	service.RegisterOnGame(server.OnGame)
 	service.RegisterOnLeaveGame(server.OnLeaveGame)
+	service.RegisterOnKickPlayer(server.OnKickPlayer)
+	service.RegisterGameActivity(server.GameActivity)
+	service.RegisterIsAtTable(server.IsAtTable)
+	service.RegisterOnTurnTick(server.OnTurnTick)
 
 	return server
 }
@@ -84,10 +104,19 @@ type Service[PlayerT Player, GameT Game[PlayerT]] interface {
 	GetGame(gameId model.GameId) (GameT, error)
 	GetJoinableGames() []GameT
 	GetNonJoinableGames(userId model.UserId) []GameT
+	GetUserGame(userId model.UserId) (GameT, error)
+	GetRunningGames(userId model.UserId) []GameT
+
+	DisconnectPlayer(playerId model.PlayerId) (GameT, error)
+	ReconnectPlayer(playerId model.PlayerId) (GameT, error)
 
 	RegisterOnJoinGame(func(game GameT, player PlayerT))
 	RegisterOnGame(func(game GameT))
 	RegisterOnLeaveGame(func(game GameT, userId model.UserId))
+	RegisterOnKickPlayer(func(game GameT, userId model.UserId))
+	RegisterGameActivity(func(game GameT) time.Time)
+	RegisterIsAtTable(func(game GameT, player PlayerT) bool)
+	RegisterOnTurnTick(func(game GameT, remaining time.Duration))
 }
 
 // //////////////////////////////////////////////////
@@ -146,10 +175,55 @@ func (s *hubServer[PlayerT, GameT]) OnUserUpdate(userId model.UserId) {
 	s.BroadcastUser(user)
 }
 
+// OnUserClose keeps the seat of a user whose socket closed while playing, for them to reconnect.
+func (s *hubServer[PlayerT, GameT]) OnUserClose(userId model.UserId) {
+	user, err := s.hub.GetUser(userId)
+	if err != nil {
+		return
+	}
+	if user.IsSpectating() {
+		s.HandleStopSpectating(user)
+		return
+	}
+	if !user.HasGameId() {
+		return
+	}
+	if _, err := s.service.DisconnectPlayer(user.PlayerId()); err != nil {
+		s.logger.Info(fmt.Sprintf("[ws] user %s >>> unable to disconnect", userId), zap.Error(err))
+	}
+}
+
 func (s *hubServer[PlayerT, GameT]) GetPlayer(playerId model.PlayerId) (PlayerT, error) {
 	return s.hub.GetPlayer(playerId)
 }
 
+// //////////////////////////////////////////////////
+// activity
+
+// GameActivity returns the last time a connected player of the game sent something ( zero if none ).
+func (s *hubServer[PlayerT, GameT]) GameActivity(game GameT) time.Time {
+	var last time.Time
+	for _, player := range game.Players() {
+		user, err := s.GetUser(player.Id().UserId())
+		if err != nil || user.GameId() != game.Id() {
+			continue
+		}
+		if activity := user.LastActivity(); activity.After(last) {
+			last = activity
+		}
+	}
+	return last
+}
+
+// IsAtTable tells whether a player is still looking at the game: bots always are, users until they leave it.
+func (s *hubServer[PlayerT, GameT]) IsAtTable(game GameT, player PlayerT) bool {
+	if player.User().IsBot() {
+		return true
+	}
+	user, err := s.GetUser(player.Id().UserId())
+	return err == nil && user.GameId() == game.Id()
+}
+
 // //////////////////////////////////////////////////
 // broadcast
 
@@ -196,10 +270,38 @@ func (s *hubServer[PlayerT, GameT]) BroadcastInfoToUser(userId model.UserId, inf
 	})
 }
 
+// BroadcastLocInfoToUser sends an info that the template localizes in the language of the user.
+func (s *hubServer[PlayerT, GameT]) BroadcastLocInfoToUser(userId model.UserId, locKey string) {
+	s.hub.BroadcastToUser("info", userId, model.Data{
+		"InfoLocKey": locKey,
+	})
+}
+
 func (s *hubServer[PlayerT, GameT]) BroadcastErrorToUser(userId model.UserId, err error) {
 	s.hub.BroadcastToUser("error", userId, model.Data{
 		"Error": err.Error(),
 	})
+	if errors.Is(err, model.ErrStaleGame) {
+		s.refreshGameToUser(userId)
+	}
+}
+
+// refreshGameToUser sends the latest game to a user whose view is out of date.
+func (s *hubServer[PlayerT, GameT]) refreshGameToUser(userId model.UserId) {
+	user, err := s.GetUser(userId)
+	if err != nil || !user.HasGameId() {
+		return
+	}
+	game, err := s.service.GetGame(user.GameId())
+	if err != nil {
+		return
+	}
+	data := model.Data{
+		"Game":    game,
+		"Players": game.Players(),
+	}
+	s.hub.BroadcastToPlayer("players", user.PlayerId(), data)
+	s.hub.BroadcastToPlayer("board", user.PlayerId(), data)
 }
 
 func (s *hubServer[PlayerT, GameT]) BroadcastInfoToPlayers(game GameT, info string) {
@@ -208,6 +310,13 @@ func (s *hubServer[PlayerT, GameT]) BroadcastInfoToPlayers(game GameT, info stri
 	})
 }
 
+// BroadcastLocInfoToPlayers sends an info that the template localizes in the language of each player.
+func (s *hubServer[PlayerT, GameT]) BroadcastLocInfoToPlayers(game GameT, locKey string) {
+	s.hub.BroadcastToGamePlayers("info", game.Id(), model.Data{
+		"InfoLocKey": locKey,
+	})
+}
+
 func (s *hubServer[PlayerT, GameT]) BroadcastJoinableGamesToUser(userId model.UserId) {
 	data := s.getJoinableGamesData(userId)
 	s.hub.BroadcastToUser("select-game", userId, data)
@@ -225,6 +334,7 @@ func (s *hubServer[PlayerT, GameT]) getJoinableGamesData(userId model.UserId) mo
 	return model.Data{
 		"NewGames":        s.service.GetJoinableGames(),
 		"OtherGames":      s.service.GetNonJoinableGames(userId),
+		"RunningGames":    s.service.GetRunningGames(userId),
 		"HasWaitingUsers": len(waitingUsers) > 0,
 		"WaitingUsers":    waitingUsers,
 	}
@@ -253,8 +363,15 @@ func (s *hubServer[PlayerT, GameT]) BroadcastGame(game GameT) {
 }
 
 func (s *hubServer[PlayerT, GameT]) BroadcastPlayers(game GameT) {
+	spectators := s.hub.GetGameSpectators(game.Id())
 	s.hub.BroadcastToGamePlayers("players", game.Id(), model.Data{
-		"Players": game.Players(),
+		"Players":    game.Players(),
+		"Spectators": spectators,
+	})
+	s.hub.BroadcastToGameSpectators("players", game.Id(), model.Data{
+		"Game":       game,
+		"Players":    game.Players(),
+		"Spectators": spectators,
 	})
 }
 
@@ -262,6 +379,20 @@ func (s *hubServer[PlayerT, GameT]) BroadcastBoard(game GameT) {
 	s.hub.BroadcastToGamePlayers("board", game.Id(), model.Data{
 		"Game": game,
 	})
+	s.hub.BroadcastToGameSpectators("board", game.Id(), model.Data{
+		"Game": game,
+	})
+}
+
+func (s *hubServer[PlayerT, GameT]) BroadcastCountdown(game GameT, remaining time.Duration) {
+	s.hub.BroadcastToGamePlayers("countdown", game.Id(), model.Data{
+		"Game":      game,
+		"Remaining": int(remaining.Round(time.Second) / time.Second),
+	})
+	s.hub.BroadcastToGameSpectators("countdown", game.Id(), model.Data{
+		"Game":      game,
+		"Remaining": int(remaining.Round(time.Second) / time.Second),
+	})
 }
 
 func (s *hubServer[PlayerT, GameT]) BroadcastPlayer(player PlayerT) {
@@ -281,6 +412,56 @@ func (s *hubServer[PlayerT, GameT]) BroadcastUser(user User) {
 	s.BroadcastJoinableGames()
 }
 
+// //////////////////////////////////////////////////
+// spectate
+
+// HandleSpectateGame lets a user watch a running game: they get the board but cannot act on it.
+func (s *hubServer[PlayerT, GameT]) HandleSpectateGame(gameId model.GameId, user User) error {
+	s.logger.Info("[ws] spectate_game")
+	if gameId == "" {
+		return model.ErrMissingGameId
+	}
+	if user.HasGameId() {
+		return model.ErrAlreadyInGame
+	}
+	game, err := s.service.GetGame(gameId)
+	if err != nil {
+		return err
+	}
+	if !game.WasStarted() {
+		return model.ErrGameNotStarted
+	}
+	if game.IsPrivate() {
+		return model.ErrPrivateGame
+	}
+
+	user.Spectate(gameId)
+
+	s.hub.BroadcastToUser("game-layout", user.Id(), model.Data{
+		"Game":      game,
+		"Spectator": true,
+	})
+	s.BroadcastGame(game)
+	s.BroadcastJoinableGames()
+	return nil
+}
+
+func (s *hubServer[PlayerT, GameT]) HandleStopSpectating(user User) error {
+	s.logger.Info("[ws] stop_spectating")
+	gameId := user.SpectatedGameId()
+	if gameId == "" {
+		return model.ErrNotSpectating
+	}
+
+	user.StopSpectating()
+
+	if game, err := s.service.GetGame(gameId); err == nil {
+		s.BroadcastPlayers(game)
+	}
+	s.BroadcastJoinableGamesToUser(user.Id())
+	return nil
+}
+
 // //////////////////////////////////////////////////
 // on game events
 
@@ -303,12 +484,24 @@ func (s *hubServer[PlayerT, GameT]) OnLeaveGame(game GameT, userId model.UserId)
 	if err != nil {
 		return
 	}
-	user.UnsetGameId()
+	if user.GameId() == game.Id() {
+		user.UnsetGameId()
+	}
 
 	s.OnGame(game)
 	s.BroadcastJoinableGamesToUser(userId)
 }
 
+// OnKickPlayer sends a user kicked by the host back to the lobby, and tells them why.
+func (s *hubServer[PlayerT, GameT]) OnKickPlayer(game GameT, userId model.UserId) {
+	s.OnLeaveGame(game, userId)
+	s.BroadcastLocInfoToUser(userId, "KickedInfo")
+}
+
+func (s *hubServer[PlayerT, GameT]) OnTurnTick(game GameT, remaining time.Duration) {
+	s.BroadcastCountdown(game, remaining)
+}
+
 func (s *hubServer[PlayerT, GameT]) OnGame(game GameT) {
 	s.BroadcastGame(game)
 	s.BroadcastJoinableGames()
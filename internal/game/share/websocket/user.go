@@ -25,11 +25,18 @@ type User interface {
 
 	PlayerId() model.PlayerId
 
+	IsSpectating() bool
+	SpectatedGameId() model.GameId
+	Spectate(gameId model.GameId)
+	StopSpectating()
+
 	IsInactive() bool
 	IsActive() bool
 	IsNotPlaying() bool
 	IsPlaying() bool
 
+	LastActivity() time.Time
+
 	ConnectSocket(w http.ResponseWriter, r *http.Request) error
 
 	Activate()
@@ -89,6 +96,8 @@ type user struct {
 	logger           *zap.Logger
 	active           bool
 	gameId           model.GameId
+	spectatedGameId  model.GameId
+	lastActivity     time.Time
 	send             chan []byte
 	closeMessageSent chan struct{}
 	pingTicker       *time.Ticker
@@ -137,6 +146,34 @@ func (p *user) PlayerId() model.PlayerId {
 	return model.NewPlayerId(p.gameId, p.Id())
 }
 
+func (p *user) IsSpectating() bool {
+	unlock := p.rlock("IsSpectating")
+	defer unlock()
+
+	return p.spectatedGameId != ""
+}
+
+func (p *user) SpectatedGameId() model.GameId {
+	unlock := p.rlock("SpectatedGameId")
+	defer unlock()
+
+	return p.spectatedGameId
+}
+
+func (p *user) Spectate(gameId model.GameId) {
+	unlock := p.lock("Spectate")
+	defer unlock()
+
+	p.spectatedGameId = gameId
+}
+
+func (p *user) StopSpectating() {
+	unlock := p.lock("StopSpectating")
+	defer unlock()
+
+	p.spectatedGameId = ""
+}
+
 func (p *user) IsInactive() bool {
 	unlock := p.rlock("IsInactive")
 	defer unlock()
@@ -155,7 +192,7 @@ func (p *user) IsNotPlaying() bool {
 	unlock := p.rlock("IsNotPlaying")
 	defer unlock()
 
-	return p.active && p.gameId == ""
+	return p.active && p.gameId == "" && p.spectatedGameId == ""
 
 }
 func (p *user) IsPlaying() bool {
@@ -165,6 +202,20 @@ func (p *user) IsPlaying() bool {
 	return p.active && p.gameId != ""
 }
 
+func (p *user) LastActivity() time.Time {
+	unlock := p.rlock("LastActivity")
+	defer unlock()
+
+	return p.lastActivity
+}
+
+func (p *user) touch() {
+	unlock := p.lock("touch")
+	defer unlock()
+
+	p.lastActivity = time.Now()
+}
+
 func (p *user) ConnectSocket(w http.ResponseWriter, r *http.Request) error {
 	logger := p.logger.With(zap.String("routine", "connect-socket"))
 	conn, err := upgrader.Upgrade(w, r, nil)
@@ -225,6 +276,7 @@ func (p *user) ReadSocket() {
 		if DebugMessage {
 			logger.Info(fmt.Sprintf("[ws] user %v ← receive message ← %s", p.Id(), message))
 		}
+		p.touch()
 		if p.onMessage != nil {
 			p.onMessage(p.Id(), message)
 		}
@@ -347,6 +399,7 @@ func (p *user) Open(conn *ws.Conn) {
 	p.writeClosed = false
 	p.closing = false
 	p.closed = false
+	p.lastActivity = time.Now()
 	unlock()
 
 	logger.Info(fmt.Sprintf("[ws] user %v → OPEN -> Activate", p.Id()))
@@ -368,10 +421,6 @@ func (p *user) Close() {
 	p.closing = true
 	unlock()
 
-	if p.onClose != nil {
-		p.onClose(p.Id())
-	}
-
 	logger.Info(fmt.Sprintf("[ws] user %v → stop ping ticker", p.Id()))
 	p.pingTicker.Stop()
 
@@ -401,6 +450,12 @@ func (p *user) Close() {
 
 	logger.Info(fmt.Sprintf("[ws] user %v → CLOSED → DEACTIVATE", p.Id()))
 	p.Deactivate()
+
+	// called last, so that nothing is sent to the closed socket anymore
+	if p.onClose != nil {
+		logger.Info(fmt.Sprintf("[ws] user %v → CLOSED → callback", p.Id()))
+		p.onClose(p.Id())
+	}
 }
 
 func (p *user) Activate() {
@@ -3,6 +3,8 @@ package api
 import (
 	"embed"
 	"html/template"
+
+	"github.com/gre-ory/games-go/internal/util"
 )
 
 var (
@@ -12,6 +14,11 @@ var (
 
 var (
 	tpl = template.Must(
-		template.ParseFS(tplFS, "tpl/*.tpl"),
+		template.
+			New("").
+			Funcs(template.FuncMap{
+				"dict": util.TplDict,
+			}).
+			ParseFS(tplFS, "tpl/*.tpl"),
 	)
 )
@@ -0,0 +1,107 @@
+{{- define "board" }}
+{{- $lang := .Lang }}
+{{- if .Game.WasStarted }}
+{{- $game := .Game }}
+{{- $current_player := .Player }}
+{{- $playing := and (not .Spectator) .Player.IsPlaying }}
+{{- $draw := and $playing .Game.CanDraw }}
+{{- $put := and $playing .Game.HasSelectedCard }}
+{{- $discard := and $playing .Game.CanDiscard }}
+{{- $flip := and $playing (or .Game.IsOpening .Game.ShouldFlip) }}
+<div id="board" class="{{ if .Spectator }}spectator{{ else }}{{ .Player.Labels }}{{ end }}" data-version="{{ .Game.Version }}" hx-swap-oob="outerHTML">
+    <div class="rules center">{{ $lang.Loc "ScoreThresholdRule" .Game.ScoreThreshold }}</div>
+    <div class="decks cols-3">
+        <div class="col-1 item">
+            <div class="title center">{{ $lang.Loc "DrawPile" }}</div>
+            {{- if $draw }}
+            <div class="{{ .Game.DrawDeck.Labels }} select" ws-send data-action="draw-card">{{ .Game.DrawDeck.Size }}</div>
+            {{- else }}
+            <div class="{{ .Game.DrawDeck.Labels }}">{{ .Game.DrawDeck.Size }}</div>
+            {{- end }}
+        </div>
+        <div class="col-1 item">
+            <div class="title center">{{ $lang.Loc "DiscardPile" }}</div>
+            {{- if .Game.DiscardDeck.IsEmpty }}
+            <div class="{{ .Game.DiscardDeck.Labels }}">&nbsp;</div>
+            {{- else if $draw }}
+            <div class="{{ .Game.DiscardDeck.Labels }} select" ws-send data-action="draw-discard-card">{{ .Game.TopDiscardCard }}</div>
+            {{- else if $discard }}
+            <div class="{{ .Game.DiscardDeck.Labels }} select" ws-send data-action="discard-card">{{ .Game.TopDiscardCard }}</div>
+            {{- else }}
+            <div class="{{ .Game.DiscardDeck.Labels }}">{{ .Game.TopDiscardCard }}</div>
+            {{- end }}
+        </div>
+        <div class="col-1 item">
+            <div class="title center">{{ $lang.Loc "DrawnCard" }}</div>
+            {{- if .Game.HasSelectedCard }}
+            <div class="selected card">{{ .Game.SelectedCardValue }}</div>
+            {{- else }}
+            <div class="selected card empty">&nbsp;</div>
+            {{- end }}
+        </div>
+    </div>
+    <div class="boards">
+    {{- range .Game.PlayersInOrder }}
+    {{- $player := . }}
+    {{- with $game.Board .Id }}
+        <div class="player-board item">
+            <div class="title center">
+                {{ $player.User.Avatar.XS }}
+                <div class="name truncate">{{ $player.User.Name }}</div>
+            </div>
+            {{- if and $current_player (eq $player.Id $current_player.Id) }}
+            {{ template "my-board" dict "Board" . "Put" $put "Flip" $flip }}
+            {{- else }}
+            {{ template "player-board" . }}
+            {{- end }}
+        </div>
+    {{- end }}
+    {{- end }}
+    </div>
+    {{- if .Game.ScoreSheet }}
+    <div class="center">
+        {{ template "score-sheet" .Game }}
+    </div>
+    {{- end }}
+    <div id="countdown" class="countdown center"></div>
+    <div class="center">
+        {{- if and .Game.IsStopped (not .Spectator) }}
+        <button ws-send data-action="rematch">{{ $lang.Loc "RematchAction" }}</button>
+        {{- end }}
+        <button ws-send data-action="leave-game">{{ $lang.Loc "LeaveAction" }}</button>
+    </div>
+</div>
+{{- else }}
+<div id="board" class="center" hx-swap-oob="outerHTML">
+    {{- if .Game.IsPrivate }}
+    <div class="invite center">
+        {{ $lang.Loc "InviteLink" }} <a href="/skj/join/{{ .Game.InviteCode }}">/skj/join/{{ .Game.InviteCode }}</a>
+    </div>
+    {{- end }}
+    <div class="rules center">{{ $lang.Loc "ScoreThresholdRule" .Game.ScoreThreshold }}</div>
+    {{- $host := .Game.IsHost .Player.Id }}
+    {{- if .Player.IsReady }}
+    <button ws-send data-action="set-ready" data-ready="false">{{ $lang.Loc "NotReadyAction" }}</button>
+    {{- else }}
+    <button ws-send data-action="set-ready" data-ready="true">{{ $lang.Loc "ReadyAction" }}</button>
+    {{- end }}
+    {{- if and $host .Game.IsReadyToStart }}
+    <button ws-send data-action="start-game">{{ $lang.Loc "StartAction" }}</button>
+    {{- else }}
+    <button class="off">{{ $lang.Loc "StartAction" }}</button>
+    {{- end }}
+    {{- if $host }}
+    <button ws-send data-action="update-settings" data-turn-timeout="{{ .Game.TurnTimeout.Seconds }}" data-private="{{ not .Game.IsPrivate }}" data-ready-check="{{ .Game.HasReadyCheck }}">
+        {{- if .Game.IsPrivate }}{{ $lang.Loc "MakePublicAction" }}{{ else }}{{ $lang.Loc "MakePrivateAction" }}{{ end -}}
+    </button>
+    <button ws-send data-action="update-settings" data-turn-timeout="{{ .Game.TurnTimeout.Seconds }}" data-private="{{ .Game.IsPrivate }}" data-ready-check="{{ not .Game.HasReadyCheck }}">
+        {{- if .Game.HasReadyCheck }}{{ $lang.Loc "NoReadyCheckAction" }}{{ else }}{{ $lang.Loc "ReadyCheckAction" }}{{ end -}}
+    </button>
+    {{- end }}
+    {{- if and $host .Game.CanJoin }}
+    <button ws-send data-action="add-bot">{{ $lang.Loc "AddBotAction" }}</button>
+    {{- end }}
+    <button ws-send data-action="leave-game">{{ $lang.Loc "LeaveAction" }}</button>
+</div>
+{{- end }}
+{{- end }}
\ No newline at end of file
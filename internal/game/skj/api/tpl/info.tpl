@@ -0,0 +1,8 @@
+{{- define "info" }}
+<div id="notifications" hx-swap-oob="innerHTML">
+    <div class="info">
+        <div class="icon-info"></div>
+        <div class="message">{{ with .InfoLocKey }}{{ $.Lang.Loc . }}{{ else }}{{ .Info }}{{ end }}</div>
+    </div>
+</div>
+{{- end }}
@@ -1,8 +1,8 @@
 {{- define "my-board" }}
-    <div class="my {{ .Labels }}">
-        <div class="score">{{ .Total }}</div>
-        {{- range $columnIndex, $column := .Columns }}
-            {{ template "my-column" $column }}
+    <div class="my {{ .Board.Labels }}">
+        <div class="score">{{ .Board.Total }}</div>
+        {{- range $columnIndex, $column := .Board.Columns }}
+            {{ template "my-column" dict "Column" $column "Put" $.Put "Flip" $.Flip }}
         {{- end }}
     </div>
 {{- end }}
\ No newline at end of file
@@ -1,11 +1,13 @@
 {{- define "my-cell" }}
-    {{- if .CanFlip }}
-        <div class="my {{ .Labels }} select" ws-send data-action="flip" data-column="{{ .Column }}" data-row="{{ .Row }}">
-    {{- else if .IsEmpty }}
-        <div class="my {{ .Labels }}">
+    {{- if .Put }}
+        <div class="my {{ .Cell.Labels }} select" ws-send data-action="put-card" data-column="{{ .Cell.Column }}" data-row="{{ .Cell.Row }}">
+    {{- else if and .Flip .Cell.CanFlip }}
+        <div class="my {{ .Cell.Labels }} select" ws-send data-action="flip-card" data-column="{{ .Cell.Column }}" data-row="{{ .Cell.Row }}">
+    {{- else }}
+        <div class="my {{ .Cell.Labels }}">
     {{- end }}
-        {{- if .IsVisible }}
-            {{ .Card }}
+        {{- if .Cell.IsVisible }}
+            {{ .Cell.Card }}
         {{- else }}
             &nbsp;
         {{- end }}
@@ -1,7 +1,7 @@
 {{- define "my-column" }}
-    <div class="my {{ .Labels }}">
-        {{- range $rowIndex, $cell := .Cells }}
-            {{ template "my-cell" $cell }}
+    <div class="my {{ .Column.Labels }}">
+        {{- range $rowIndex, $cell := .Column.Cells }}
+            {{ template "my-cell" dict "Cell" $cell "Put" $.Put "Flip" $.Flip }}
         {{- end }}
     </div>
 {{- end }}
\ No newline at end of file
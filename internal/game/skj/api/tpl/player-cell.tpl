@@ -1,9 +1,5 @@
 {{- define "player-cell" }}
-    {{- if .CanFlip }}
-        <div class="{{ .Labels }} select" ws-send data-action="flip" data-column="{{ .Column }}" data-row="{{ .Row }}">
-    {{- else if .IsEmpty }}
-        <div class="{{ .Labels }}">
-    {{- end }}
+    <div class="{{ .Labels }}">
         {{- if .IsVisible }}
             {{ .Card }}
         {{- else }}
@@ -0,0 +1,50 @@
+{{- define "players" }}
+{{- $lang := .Lang }}
+<div id="players" hx-swap-oob="outerHTML">
+    <div class="players cols-2">
+    {{- $game := .Game }}
+    {{- $current_player := .Player }}
+    {{- range .Game.Players }}
+        {{- if and $current_player (eq .Id $current_player.Id) }}
+            <div class="{{ $game.PlayerLabels .Id }} current col-1 item">
+        {{- else }}
+            <div class="{{ $game.PlayerLabels .Id }} col-1 item">
+        {{- end }}
+                <div class="title center">
+                    {{ .User.Avatar.XS }}
+                    <div class="name truncate">{{ .User.Name }}</div>
+                    {{- if $game.IsHost .Id }}
+                    <div class="host">{{ $lang.Loc "Host" }}</div>
+                    {{- end }}
+                </div>
+                <div class="content center">
+                    {{- if and $current_player (eq .Id $current_player.Id) }}
+                        {{ $game.YourPlayerMessage $lang .Id }}
+                    {{- else }}
+                        {{ $game.PlayerMessage $lang .Id }}
+                    {{- end }}
+                    {{- if and $current_player (not $game.WasStarted) ($game.IsHost $current_player.Id) (ne .Id $current_player.Id) }}
+                    <button ws-send data-action="kick-player" data-player="{{ .Id }}">{{ $lang.Loc "KickAction" }}</button>
+                    {{- end }}
+                    {{- if $game.IsRematch }}
+                    <div class="table-wins">{{ $lang.Loc "TableWins" ($game.NbTableWin .Id) $game.NbTableGame }}</div>
+                    {{- end }}
+                </div>
+            </div>
+    {{- end }}
+    </div>
+    {{- with .Spectators }}
+    <div class="spectators item">
+        <div class="title center">{{ $lang.Loc "Spectators" }}</div>
+        <div class="content left">
+        {{- range . }}
+            <div class="badge user spectator">
+                {{ .Avatar.XS }}
+                <div class="name truncate">{{ .Name }}</div>
+            </div>
+        {{- end }}
+        </div>
+    </div>
+    {{- end }}
+</div>
+{{- end }}
\ No newline at end of file
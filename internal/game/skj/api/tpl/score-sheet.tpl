@@ -0,0 +1,24 @@
+{{- define "score-sheet" }}
+    <table class="score-sheet">
+        <tr>
+            <th>#</th>
+            {{- range $player := .PlayersInOrder }}
+                <th class="name truncate">{{ $player.User.Name }}</th>
+            {{- end }}
+        </tr>
+        {{- range $round := .ScoreSheet }}
+            <tr>
+                <td>{{ $round.Number }}</td>
+                {{- range $player := $.PlayersInOrder }}
+                    <td class="{{ $round.PlayerLabels $player.Id }}">{{ $round.Score $player.Id }}</td>
+                {{- end }}
+            </tr>
+        {{- end }}
+        <tr class="total">
+            <td>/ {{ .ScoreThreshold }}</td>
+            {{- range $player := .PlayersInOrder }}
+                <td class="{{ $player.Labels }}">{{ $player.Score }}</td>
+            {{- end }}
+        </tr>
+    </table>
+{{- end }}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+
+	"github.com/gre-ory/games-go/internal/game/skj/model"
+	"github.com/gre-ory/games-go/internal/game/skj/service"
+	"github.com/gre-ory/games-go/internal/game/skj/store"
+)
+
+// hiddenCardValue is only dealt face down, so that it never shows up on a masked board.
+const hiddenCardValue = 12
+
+// newTestBoard deals a board whose first row is face up with zeros and whose other cards are face down.
+func newTestBoard() *model.PlayerBoard {
+	board := model.NewPlayerBoard()
+	for columnNumber := 1; columnNumber <= model.NbColumn; columnNumber++ {
+		column := model.NewPlayerColumn(columnNumber)
+		column.AddCell(model.NewPlayerCell(columnNumber, 1, 0))
+		for rowNumber := 2; rowNumber <= model.NbRow; rowNumber++ {
+			column.AddCell(model.NewPlayerCell(columnNumber, rowNumber, hiddenCardValue))
+		}
+		board.AddColumn(column)
+		if err := board.Flip(columnNumber-1, 0); err != nil {
+			panic(err)
+		}
+	}
+	return board
+}
+
+func TestSpectatorBoard(t *testing.T) {
+
+	gameService := service.NewGameService(zap.NewNop(), store.NewGameStore())
+	game, err := gameService.CreateGame(share_model.NewUser("U1"), share_model.GameOptions{})
+	require.NoError(t, err)
+	game, err = gameService.JoinGame(game, share_model.NewUser("U2"))
+	require.NoError(t, err)
+	game, err = gameService.StartGame(game)
+	require.NoError(t, err)
+	for _, player := range game.Players() {
+		game.AddBoard(player.Id(), newTestBoard())
+	}
+
+	var buf bytes.Buffer
+	err = tpl.ExecuteTemplate(&buf, "board", share_model.Data{
+		"Game":      game,
+		"Spectator": true,
+		"Lang":      model.App.UserLocalizer(share_model.NewUser("U3")),
+	})
+	require.NoError(t, err)
+	html := buf.String()
+
+	// face down cards are masked & no card can be acted on
+	cells := regexp.MustCompile(`<div class="(cell[^"]*)">\s*([^<]*?)\s*</div>`).FindAllStringSubmatch(html, -1)
+	require.Len(t, cells, 2*model.NbColumn*model.NbRow)
+	nbHidden := 0
+	for _, cell := range cells {
+		if cell[1] == "cell flipped" {
+			require.Equal(t, "0", cell[2])
+			continue
+		}
+		require.Equal(t, "cell", cell[1])
+		require.Equal(t, "&nbsp;", cell[2])
+		nbHidden++
+	}
+	require.Equal(t, 2*model.NbColumn*(model.NbRow-1), nbHidden)
+	require.NotContains(t, html, "ws-send data-action=\"flip-card\"")
+	require.NotContains(t, html, "ws-send data-action=\"put-card\"")
+	require.NotContains(t, html, "ws-send data-action=\"draw-card\"")
+}
+
+func TestScoreSheetBoard(t *testing.T) {
+
+	gameService := service.NewGameService(zap.NewNop(), store.NewGameStore())
+	game, err := gameService.CreateGame(share_model.NewUser("U1"), share_model.GameOptions{})
+	require.NoError(t, err)
+	game, err = gameService.JoinGame(game, share_model.NewUser("U2"))
+	require.NoError(t, err)
+	game, err = gameService.StartGame(game)
+	require.NoError(t, err)
+	render := func() string {
+		var buf bytes.Buffer
+		err := tpl.ExecuteTemplate(&buf, "board", share_model.Data{
+			"Game":      game,
+			"Spectator": true,
+			"Lang":      model.App.UserLocalizer(share_model.NewUser("U3")),
+		})
+		require.NoError(t, err)
+		return buf.String()
+	}
+	require.NotContains(t, render(), "score-sheet")
+
+	// the breakdown of the previous deals shows between rounds
+	game.ScoreSheet = append(game.ScoreSheet, model.RoundScore{
+		Number: 1,
+		Scores: map[share_model.PlayerId]int{
+			game.PlayersInOrder()[0].Id(): 17,
+			game.PlayersInOrder()[1].Id(): 23,
+		},
+	})
+	html := render()
+	require.Contains(t, html, "score-sheet")
+	require.Regexp(t, `<td class="round-score">17</td>\s*<td class="round-score">23</td>`, html)
+}
+
+func TestReshuffleInfo(t *testing.T) {
+
+	for lang, want := range map[share_model.UserLanguage]string{
+		share_model.UserLanguage_En: "The discard pile was shuffled into a new draw pile.",
+		share_model.UserLanguage_Fr: "La défausse a été mélangée pour former une nouvelle pioche.",
+	} {
+		user := share_model.NewUser("U1")
+		user.SetLanguage(lang)
+		var buf bytes.Buffer
+		err := tpl.ExecuteTemplate(&buf, "info", share_model.Data{
+			"InfoLocKey": "ReshuffleInfo",
+			"Lang":       model.App.UserLocalizer(user),
+		})
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), want, lang)
+	}
+}
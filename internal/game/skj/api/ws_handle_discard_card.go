@@ -6,10 +6,10 @@ import (
 	"github.com/gre-ory/games-go/internal/game/skj/model"
 )
 
-func (s *gameServer) HandleDiscardCard(player *model.Player) error {
+func (s *gameServer) HandleDiscardCard(player *model.Player, version int) error {
 	s.logger.Info("[ws] discard card", zap.Any("player", player))
 
-	game, err := s.service.DiscardCard(player)
+	game, err := s.service.DiscardCard(player, version)
 	if err != nil {
 		return err
 	}
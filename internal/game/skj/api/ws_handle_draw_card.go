@@ -6,10 +6,10 @@ import (
 	"github.com/gre-ory/games-go/internal/game/skj/model"
 )
 
-func (s *gameServer) HandleDrawCard(player *model.Player) error {
+func (s *gameServer) HandleDrawCard(player *model.Player, version int) error {
 	s.logger.Info("[ws] draw card", zap.Any("player", player))
 
-	game, err := s.service.DrawCard(player)
+	game, err := s.service.DrawCard(player, version)
 	if err != nil {
 		return err
 	}
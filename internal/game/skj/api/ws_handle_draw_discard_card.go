@@ -6,10 +6,10 @@ import (
 	"github.com/gre-ory/games-go/internal/game/skj/model"
 )
 
-func (s *gameServer) HandleDrawDiscardCard(player *model.Player) error {
+func (s *gameServer) HandleDrawDiscardCard(player *model.Player, version int) error {
 	s.logger.Info("[ws] draw discard card", zap.Any("player", player))
 
-	game, err := s.service.DrawDiscardCard(player)
+	game, err := s.service.DrawDiscardCard(player, version)
 	if err != nil {
 		return err
 	}
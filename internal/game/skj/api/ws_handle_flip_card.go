@@ -6,7 +6,7 @@ import (
 	"github.com/gre-ory/games-go/internal/game/skj/model"
 )
 
-func (s *gameServer) HandleFlipCard(player *model.Player, columnNumber, rowNumber int) error {
+func (s *gameServer) HandleFlipCard(player *model.Player, version, columnNumber, rowNumber int) error {
 	s.logger.Info("[ws] flip card", zap.Int("column", columnNumber), zap.Int("row", rowNumber))
 
 	if columnNumber == 0 {
@@ -16,7 +16,7 @@ func (s *gameServer) HandleFlipCard(player *model.Player, columnNumber, rowNumbe
 		return model.ErrInvalidRow
 	}
 
-	game, err := s.service.FlipCard(player, columnNumber, rowNumber)
+	game, err := s.service.FlipCard(player, version, columnNumber, rowNumber)
 	if err != nil {
 		return err
 	}
@@ -38,6 +38,24 @@ func (board *PlayerBoard) IsFlipped() bool {
 	return true
 }
 
+// FlipFirst flips the first face down card of the board.
+func (board *PlayerBoard) FlipFirst() error {
+	for _, column := range board.columns {
+		for _, cell := range column.cells {
+			if cell.CanFlip() {
+				return cell.Flip()
+			}
+		}
+	}
+	return ErrCardAlreadyFlipped
+}
+
+func (board *PlayerBoard) Reveal() {
+	for _, column := range board.columns {
+		column.Reveal()
+	}
+}
+
 func (board *PlayerBoard) Total() int {
 	result := 0
 	for _, column := range board.columns {
@@ -47,19 +65,38 @@ func (board *PlayerBoard) Total() int {
 }
 
 func (board *PlayerBoard) Put(card Card, columnIndex, rowIndex int) (Card, error) {
-	if columnIndex < 0 || columnIndex >= NbColumn {
+	if columnIndex < 0 || columnIndex >= len(board.columns) {
 		return Card_Unknown, ErrInvalidColumn
 	}
 	return board.columns[columnIndex].Put(card, rowIndex)
 }
 
 func (board *PlayerBoard) Flip(columnIndex, rowIndex int) error {
-	if columnIndex < 0 || columnIndex >= NbColumn {
+	if columnIndex < 0 || columnIndex >= len(board.columns) {
 		return ErrInvalidColumn
 	}
 	return board.columns[columnIndex].Flip(rowIndex)
 }
 
+// RemoveSkyjoColumns takes the skyjo columns off the board and returns their cards.
+// Remaining columns are renumbered so that the board layout closes the gaps.
+func (board *PlayerBoard) RemoveSkyjoColumns() []Card {
+	removed := make([]Card, 0)
+	columns := make([]*PlayerColumn, 0, len(board.columns))
+	for _, column := range board.columns {
+		if column.IsSkyjo() {
+			for _, cell := range column.cells {
+				removed = append(removed, cell.card)
+			}
+			continue
+		}
+		column.setNumber(len(columns) + 1)
+		columns = append(columns, column)
+	}
+	board.columns = columns
+	return removed
+}
+
 func (board *PlayerBoard) Labels() string {
 	labels := make([]string, 0)
 	labels = append(labels, "board")
@@ -93,6 +130,13 @@ func (column *PlayerColumn) Cells() []*PlayerCell {
 	return column.cells
 }
 
+func (column *PlayerColumn) setNumber(columnNumber int) {
+	column.columnNumber = columnNumber
+	for _, cell := range column.cells {
+		cell.columnNumber = columnNumber
+	}
+}
+
 func (column *PlayerColumn) IsSkyjo() bool {
 	firstCell := column.cells[0]
 	for _, cell := range column.cells {
@@ -129,6 +173,12 @@ func (column *PlayerColumn) Flip(rowIndex int) error {
 	return column.cells[rowIndex].Flip()
 }
 
+func (column *PlayerColumn) Reveal() {
+	for _, cell := range column.cells {
+		cell.flipped = true
+	}
+}
+
 func (column *PlayerColumn) Total() int {
 	if column.IsSkyjo() {
 		return 0
@@ -184,7 +234,7 @@ func (cell *PlayerCell) Card() int {
 }
 
 func (cell *PlayerCell) IsVisible() bool {
-	return !cell.flipped
+	return cell.flipped
 }
 
 func (cell *PlayerCell) IsFlipped() bool {
@@ -211,7 +261,7 @@ func (cell *PlayerCell) Flip() error {
 }
 
 func (cell *PlayerCell) Total() int {
-	if cell.IsFlipped() {
+	if !cell.IsFlipped() {
 		return 0
 	}
 	return int(cell.card)
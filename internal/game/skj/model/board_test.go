@@ -0,0 +1,46 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveSkyjoColumns(t *testing.T) {
+	board := NewPlayerBoard()
+	for columnIndex, cards := range [][]Card{{5, 5, 5}, {1, 2, 3}, {-1, -1, -1}, {7, 7, 7}} {
+		column := NewPlayerColumn(columnIndex + 1)
+		for rowIndex, card := range cards {
+			column.AddCell(NewPlayerCell(columnIndex+1, rowIndex+1, card))
+		}
+		board.AddColumn(column)
+	}
+	for _, columnIndex := range []int{0, 1, 2} {
+		for rowIndex := 0; rowIndex < NbRow; rowIndex++ {
+			require.NoError(t, board.Flip(columnIndex, rowIndex))
+		}
+	}
+
+	//
+	// only flipped columns of identical cards are removed
+	//
+
+	removed := board.RemoveSkyjoColumns()
+	require.Equal(t, []Card{5, 5, 5, -1, -1, -1}, removed)
+	require.Len(t, board.Columns(), 2)
+	require.Equal(t, 6, board.Total())
+
+	//
+	// remaining columns are renumbered
+	//
+
+	for columnIndex, column := range board.Columns() {
+		for _, cell := range column.Cells() {
+			require.Equal(t, columnIndex+1, cell.Column())
+		}
+	}
+	require.NoError(t, board.Flip(1, 0))
+	require.ErrorIs(t, board.Flip(2, 0), ErrInvalidColumn)
+	_, err := board.Put(Card(4), 2, 0)
+	require.ErrorIs(t, err, ErrInvalidColumn)
+}
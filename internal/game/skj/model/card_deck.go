@@ -7,14 +7,14 @@ import (
 
 type CardDeck []Card
 
-func NewDrawCardDeck() CardDeck {
+func NewDrawCardDeck(random *rand.Rand) CardDeck {
 	deck := CardDeck{}
-	for card, nb := range Card_NbPerValue {
-		for i := 0; i < nb; i++ {
+	for card := Card(Card_MinValue); card <= Card_MaxValue; card++ {
+		for i := 0; i < Card_NbPerValue[card]; i++ {
 			deck.Add(card)
 		}
 	}
-	deck.Shuffle()
+	deck.Shuffle(random)
 	return deck
 }
 
@@ -50,8 +50,8 @@ func (d *CardDeck) Add(card Card) {
 	*d = append(*d, card)
 }
 
-func (d *CardDeck) Shuffle() {
-	rand.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
+func (d *CardDeck) Shuffle(random *rand.Rand) {
+	random.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
 }
 
 func (d CardDeck) Labels() string {
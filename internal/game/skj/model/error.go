@@ -13,7 +13,10 @@ var (
 	ErrInvalidColumn       = fmt.Errorf("invalid column")
 	ErrAlreadySelectedCard = fmt.Errorf("already selected card")
 	ErrMissingSelectedCard = fmt.Errorf("missing selected card")
+	ErrMustPutCard         = fmt.Errorf("card taken from the discard pile must be put")
 	ErrNotShouldFlip       = fmt.Errorf("not should flip")
 	ErrPlayerBoardNotFound = fmt.Errorf("player board not found")
 	ErrInvalidCardValue    = fmt.Errorf("invalid card value")
+	ErrOpeningNotOver      = fmt.Errorf("opening not over")
+	ErrOpeningFlipsDone    = fmt.Errorf("opening cards already flipped")
 )
@@ -0,0 +1,24 @@
+package model
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// event type
+
+const (
+	EventType_DrawDiscardCard share_model.EventType = "draw-discard-card"
+	EventType_DrawCard        share_model.EventType = "draw-card"
+	EventType_PutCard         share_model.EventType = "put-card"
+	EventType_DiscardCard     share_model.EventType = "discard-card"
+	EventType_FlipCard        share_model.EventType = "flip-card"
+)
+
+// //////////////////////////////////////////////////
+// event payloads
+
+type CellPayload struct {
+	Column int `json:"column"`
+	Row    int `json:"row"`
+}
@@ -1,34 +1,65 @@
 package model
 
 import (
+	"github.com/gre-ory/games-go/internal/util/list"
+
 	share_model "github.com/gre-ory/games-go/internal/game/share/model"
 )
 
 const (
-	MinNbPlayer = 2
-	MaxNbPlayer = 4
+	MinNbPlayer   = 2
+	MaxNbPlayer   = 4
+	NbOpeningFlip = 2
 )
 
-func NewGame(nbRow, nbColumn int) *Game {
+// Settings are chosen when creating a game ( zero values mean the game defaults ).
+type Settings struct {
+	// ScoreThreshold ends the game once a player reaches it.
+	ScoreThreshold int `json:"score-threshold,omitempty"`
+}
+
+// NewGameFromSetup applies the game settings to a new game.
+func NewGameFromSetup(setup share_model.GameSetup, nbRow, nbColumn int) (*Game, error) {
+	settings := Settings{}
+	if err := setup.DecodeSettings(&settings); err != nil {
+		return nil, err
+	}
+	game := NewGame(setup, nbRow, nbColumn)
+	if settings.ScoreThreshold > 0 {
+		game.ScoreThreshold = settings.ScoreThreshold
+	}
+	return game, nil
+}
+
+func NewGame(setup share_model.GameSetup, nbRow, nbColumn int) *Game {
+	game := share_model.NewGame[*Player](setup, MinNbPlayer, MaxNbPlayer)
+	game.SetLowestScoreFirst(true)
 	return &Game{
-		Game:        share_model.NewGame[*Player](MinNbPlayer, MaxNbPlayer),
-		NbRow:       nbRow,
-		NbColumn:    nbColumn,
-		DrawDeck:    NewDrawCardDeck(),
-		DiscardDeck: NewDiscardCardDeck(),
-		boards:      make(map[share_model.PlayerId]*PlayerBoard),
+		BaseGame:       game,
+		NbRow:          nbRow,
+		NbColumn:       nbColumn,
+		ScoreThreshold: DefaultScoreThreshold,
+		ScoreSheet:     make([]RoundScore, 0),
+		DrawDeck:       NewDrawCardDeck(game.Random()),
+		DiscardDeck:    NewDiscardCardDeck(),
+		boards:         make(map[share_model.PlayerId]*PlayerBoard),
 	}
 }
 
 type Game struct {
-	share_model.Game[*Player]
-	NbRow        int
-	NbColumn     int
-	DrawDeck     CardDeck
-	DiscardDeck  CardDeck
-	SelectedCard *Card
-	ShouldFlip   bool
-	boards       map[share_model.PlayerId]*PlayerBoard
+	*share_model.BaseGame[*Player]
+	NbRow          int
+	NbColumn       int
+	ScoreThreshold int
+	ScoreSheet     []RoundScore
+	DrawDeck       CardDeck
+	DiscardDeck    CardDeck
+	SelectedCard   *Card
+	FromDiscard    bool
+	ShouldFlip     bool
+	FinisherId     share_model.PlayerId
+	OpeningFlips   map[share_model.PlayerId]int
+	boards         map[share_model.PlayerId]*PlayerBoard
 }
 
 func (g *Game) CanJoin() bool {
@@ -47,3 +78,196 @@ func (g *Game) GetBoard(playerId share_model.PlayerId) (*PlayerBoard, bool) {
 	board, found := g.boards[playerId]
 	return board, found
 }
+
+// Board returns the board of a player ( nil before the deal ), for templates.
+func (g *Game) Board(playerId share_model.PlayerId) *PlayerBoard {
+	return g.boards[playerId]
+}
+
+// RecycleDiscardDeck shuffles all but the top card of the discard pile into a new draw pile.
+func (g *Game) RecycleDiscardDeck() error {
+	if g.DiscardDeck.Size() <= 1 {
+		return ErrEmptyCardDeck
+	}
+	top := len(g.DiscardDeck) - 1
+	g.DrawDeck = append(NewDiscardCardDeck(), g.DiscardDeck[:top]...)
+	g.DrawDeck.Shuffle(g.Random())
+	g.DiscardDeck = CardDeck{g.DiscardDeck[top]}
+	return nil
+}
+
+// PlayersInOrder lists players in playing order, for the score sheet.
+func (g *Game) PlayersInOrder() []*Player {
+	players := make([]*Player, 0, g.NbPlayer())
+	for index := range g.Order() {
+		players = append(players, g.OrderedPlayer(index))
+	}
+	return players
+}
+
+// CanDraw tells whether the round player has yet to draw a card.
+func (g *Game) CanDraw() bool {
+	return !g.IsOpening() && g.SelectedCard == nil && !g.ShouldFlip
+}
+
+func (g *Game) HasSelectedCard() bool {
+	return g.SelectedCard != nil
+}
+
+// CanDiscard tells whether the selected card may go back to the discard pile:
+// a card taken from the discard pile has to be put on the board.
+func (g *Game) CanDiscard() bool {
+	return g.SelectedCard != nil && !g.FromDiscard
+}
+
+func (g *Game) SelectedCardValue() int {
+	if g.SelectedCard == nil {
+		return 0
+	}
+	return int(*g.SelectedCard)
+}
+
+// TopDiscardCard returns the visible card of the discard pile.
+func (g *Game) TopDiscardCard() int {
+	card, err := g.DiscardDeck.GetTopCard()
+	if err != nil {
+		return 0
+	}
+	return int(card)
+}
+
+// NewDeal shuffles a new draw deck and clears the boards for the next round.
+func (g *Game) NewDeal() {
+	g.DrawDeck = NewDrawCardDeck(g.Random())
+	g.DiscardDeck = NewDiscardCardDeck()
+	g.SelectedCard = nil
+	g.FromDiscard = false
+	g.ShouldFlip = false
+	g.FinisherId = ""
+	g.boards = make(map[share_model.PlayerId]*PlayerBoard)
+}
+
+// //////////////////////////////////////////////////
+// opening
+
+func (g *Game) IsOpening() bool {
+	return g.OpeningFlips != nil
+}
+
+// StartOpening lets every player flip their opening cards at the same time.
+func (g *Game) StartOpening() {
+	g.OpeningFlips = make(map[share_model.PlayerId]int, g.NbPlayer())
+	playerIds := make([]share_model.PlayerId, 0, g.NbPlayer())
+	for _, player := range g.Players() {
+		g.OpeningFlips[player.Id()] = 0
+		playerIds = append(playerIds, player.Id())
+	}
+	g.SetPlayingPlayer(playerIds...)
+}
+
+func (g *Game) IsOpeningOver() bool {
+	for _, nbFlip := range g.OpeningFlips {
+		if nbFlip < NbOpeningFlip {
+			return false
+		}
+	}
+	return true
+}
+
+// StopOpening ends the opening.
+// On the first round, the player with the highest visible total starts and the others follow in seating order.
+func (g *Game) StopOpening() {
+	g.OpeningFlips = nil
+	if g.Round() != 0 {
+		// the finisher of the previous round starts
+		return
+	}
+
+	seats := g.Order()
+	starterIndex, starterTotal := 0, 0
+	for index := range seats {
+		board, found := g.GetBoard(g.OrderedPlayerId(index))
+		if !found {
+			continue
+		}
+		if total := board.Total(); index == 0 || total > starterTotal {
+			starterIndex, starterTotal = index, total
+		}
+	}
+
+	//
+	// first round plays the order at index 1
+	//
+
+	g.FirstRound()
+	order := make([][]share_model.PlayerId, 0, len(seats))
+	for index := range seats {
+		order = append(order, seats[(starterIndex+index-g.Round()+len(seats))%len(seats)])
+	}
+	g.SetOrder(order)
+}
+
+// //////////////////////////////////////////////////
+// round
+
+func (g *Game) HasFinisher() bool {
+	return g.FinisherId != ""
+}
+
+// IsLastTurnOver tells whether every other player had their last turn since the finisher flipped their whole board.
+func (g *Game) IsLastTurnOver() bool {
+	return g.HasFinisher() && g.RoundPlayerId() == g.FinisherId
+}
+
+// ScoreRound reveals all boards, records their score on the score sheet and adds it to the players' score.
+// The finisher's score is doubled when positive and not strictly the lowest.
+func (g *Game) ScoreRound() {
+	totals := make(map[share_model.PlayerId]int, len(g.boards))
+	for playerId, board := range g.boards {
+		board.Reveal()
+		totals[playerId] = board.Total()
+	}
+
+	doubled := false
+	if finisherTotal, found := totals[g.FinisherId]; found && finisherTotal > 0 {
+		for playerId, total := range totals {
+			if playerId != g.FinisherId && total <= finisherTotal {
+				totals[g.FinisherId] = 2 * finisherTotal
+				doubled = true
+				break
+			}
+		}
+	}
+
+	g.ScoreSheet = append(g.ScoreSheet, RoundScore{
+		Number:     len(g.ScoreSheet) + 1,
+		FinisherId: g.FinisherId,
+		Doubled:    doubled,
+		Scores:     totals,
+	})
+
+	for _, player := range g.Players() {
+		player.AddScore(share_model.PlayerScore(totals[player.Id()]))
+	}
+	g.RankPlayers()
+}
+
+// IsOver tells whether a player reached the score threshold.
+func (g *Game) IsOver() bool {
+	for _, player := range g.Players() {
+		if int(player.Score()) >= g.ScoreThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// SetResults makes the players with the lowest score win.
+func (g *Game) SetResults() {
+	winners := g.FilterPlayers(func(player *Player) bool {
+		return player.Rank() == 1
+	})
+	g.SetWinners(list.Convert(winners, func(player *Player) share_model.PlayerId {
+		return player.Id()
+	})...)
+}
@@ -0,0 +1,277 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+func TestScoreRound(t *testing.T) {
+
+	type TestCase struct {
+		totals      []int
+		finisher    int
+		wantScores  []int
+		wantWinners []int
+	}
+
+	testCases := map[string]TestCase{
+		"finisher-strictly-lowest": {
+			totals:      []int{5, 10, 12},
+			finisher:    0,
+			wantScores:  []int{5, 10, 12},
+			wantWinners: []int{0},
+		},
+		"finisher-not-lowest": {
+			totals:      []int{8, 3, 12},
+			finisher:    0,
+			wantScores:  []int{16, 3, 12},
+			wantWinners: []int{1},
+		},
+		"finisher-tied-lowest": {
+			totals:      []int{4, 4},
+			finisher:    1,
+			wantScores:  []int{4, 8},
+			wantWinners: []int{0},
+		},
+		"finisher-negative": {
+			totals:      []int{-2, -5},
+			finisher:    0,
+			wantScores:  []int{-2, -5},
+			wantWinners: []int{1},
+		},
+		"tie": {
+			totals:      []int{2, 7, 2},
+			finisher:    1,
+			wantScores:  []int{2, 14, 2},
+			wantWinners: []int{0, 2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := NewGame(share_model.GameSetup{Id: "G1"}, NbRow, NbColumn)
+			players := make([]*Player, 0, len(tc.totals))
+			for i, total := range tc.totals {
+				player := NewPlayerFromUser(game.Id(), share_model.NewUser(share_model.UserId(string(rune('A'+i)))))
+				game.AttachPlayer(player)
+				game.AddBoard(player.Id(), newTestBoard(total))
+				players = append(players, player)
+			}
+			game.FinisherId = players[tc.finisher].Id()
+
+			game.ScoreRound()
+			game.SetResults()
+
+			require.Len(t, game.ScoreSheet, 1)
+			for i, player := range players {
+				board, _ := game.GetBoard(player.Id())
+				require.True(t, board.IsFlipped(), "board %d should be revealed", i)
+				require.Equal(t, tc.wantScores[i], game.ScoreSheet[0].Score(player.Id()), "round score of player %d", i)
+				require.Equal(t, share_model.PlayerScore(tc.wantScores[i]), player.Score(), "score of player %d", i)
+				wantWin := false
+				for _, winner := range tc.wantWinners {
+					wantWin = wantWin || winner == i
+				}
+				require.Equal(t, wantWin, player.Result().IsWin(), "result of player %d", i)
+			}
+		})
+	}
+}
+
+func newTestGameFromSettings(t *testing.T, setup share_model.GameSetup, settings Settings) *Game {
+	var err error
+	setup.Settings, err = share_model.EncodeSettings(settings)
+	require.NoError(t, err)
+	game, err := NewGameFromSetup(setup, NbRow, NbColumn)
+	require.NoError(t, err)
+	return game
+}
+
+func TestNewGameFromSetup(t *testing.T) {
+
+	type TestCase struct {
+		settings           json.RawMessage
+		wantScoreThreshold int
+		wantErr            error
+	}
+
+	testCases := map[string]TestCase{
+		"default": {
+			wantScoreThreshold: DefaultScoreThreshold,
+		},
+		"score-threshold": {
+			settings:           json.RawMessage(`{"score-threshold":50}`),
+			wantScoreThreshold: 50,
+		},
+		"invalid": {
+			settings: json.RawMessage(`{"score-threshold":"high"}`),
+			wantErr:  share_model.ErrInvalidSettings,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game, err := NewGameFromSetup(share_model.GameSetup{Id: "G1", Settings: tc.settings}, NbRow, NbColumn)
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr == nil {
+				require.Equal(t, tc.wantScoreThreshold, game.ScoreThreshold)
+			}
+		})
+	}
+}
+
+func TestScoreThreshold(t *testing.T) {
+	game := newTestGameFromSettings(t, share_model.GameSetup{Id: "G1"}, Settings{ScoreThreshold: 20})
+	playerA := NewPlayerFromUser(game.Id(), share_model.NewUser("A"))
+	playerB := NewPlayerFromUser(game.Id(), share_model.NewUser("B"))
+	game.AttachPlayer(playerA)
+	game.AttachPlayer(playerB)
+
+	playRound := func(totalA, totalB int) {
+		game.NewDeal()
+		game.AddBoard(playerA.Id(), newTestBoard(totalA))
+		game.AddBoard(playerB.Id(), newTestBoard(totalB))
+		game.FinisherId = playerA.Id()
+		game.ScoreRound()
+	}
+
+	playRound(5, 10)
+	require.False(t, game.IsOver())
+	require.False(t, game.ScoreSheet[0].Doubled)
+
+	playRound(8, 3)
+	require.True(t, game.ScoreSheet[1].Doubled)
+	require.Equal(t, share_model.PlayerScore(21), playerA.Score())
+	require.Equal(t, share_model.PlayerScore(13), playerB.Score())
+	require.True(t, game.IsOver())
+
+	game.SetResults()
+	require.True(t, playerB.Result().IsWin())
+	require.True(t, playerA.Result().IsLoose())
+}
+
+func TestStopOpening(t *testing.T) {
+	game := NewGame(share_model.GameSetup{Id: "G1"}, NbRow, NbColumn)
+	totals := map[share_model.UserId]int{"A": 3, "B": 9, "C": -1}
+	order := make([][]share_model.PlayerId, 0, len(totals))
+	for _, userId := range []share_model.UserId{"A", "B", "C"} {
+		player := NewPlayerFromUser(game.Id(), share_model.NewUser(userId))
+		game.AttachPlayer(player)
+		board := newTestBoard(totals[userId])
+		require.NoError(t, board.Flip(0, 0))
+		game.AddBoard(player.Id(), board)
+		order = append(order, []share_model.PlayerId{player.Id()})
+	}
+	game.SetOrder(order)
+	game.StartOpening()
+	require.Len(t, game.PlayingPlayers(), 3)
+
+	game.StopOpening()
+	require.False(t, game.IsOpening())
+	require.Equal(t, order[1][0], game.RoundPlayerId())
+
+	game.NextRound()
+	require.Equal(t, order[2][0], game.RoundPlayerId())
+	game.NextRound()
+	require.Equal(t, order[0][0], game.RoundPlayerId())
+}
+
+func TestRecycleDiscardDeck(t *testing.T) {
+	game := NewGame(share_model.GameSetup{Id: "G1", Seed: 42}, NbRow, NbColumn)
+	game.DrawDeck = CardDeck{}
+	require.ErrorIs(t, game.RecycleDiscardDeck(), ErrEmptyCardDeck)
+
+	game.DiscardDeck = CardDeck{1, 2, 3, 4, 5}
+	require.NoError(t, game.RecycleDiscardDeck())
+	require.Equal(t, CardDeck{5}, game.DiscardDeck)
+	require.ElementsMatch(t, CardDeck{1, 2, 3, 4}, game.DrawDeck)
+}
+
+func TestSnapshot(t *testing.T) {
+	game := newTestGameFromSettings(t, share_model.GameSetup{Id: "G1", Seed: 7}, Settings{ScoreThreshold: 30})
+	playerA := NewPlayerFromUser(game.Id(), share_model.NewUser("A"))
+	playerB := NewPlayerFromUser(game.Id(), share_model.NewUser("B"))
+	game.AttachPlayer(playerA)
+	game.AttachPlayer(playerB)
+	game.SetRandomOrder()
+	game.FirstRound()
+	boardA, boardB := newTestBoard(5), newTestBoard(9)
+	require.NoError(t, boardB.Flip(0, 0))
+	game.AddBoard(playerA.Id(), boardA)
+	game.AddBoard(playerB.Id(), boardB)
+	game.StartOpening()
+	game.OpeningFlips[playerB.Id()] = 1
+	card := Card(7)
+	game.SelectedCard = &card
+	game.FromDiscard = true
+	game.ShouldFlip = true
+	game.FinisherId = playerB.Id()
+	game.ScoreSheet = append(game.ScoreSheet, RoundScore{
+		Number:     1,
+		FinisherId: playerB.Id(),
+		Scores:     map[share_model.PlayerId]int{playerA.Id(): 12, playerB.Id(): 4},
+	})
+	playerA.SetScore(12)
+	playerB.SetScore(4)
+	game.Random().Intn(100)
+
+	data, err := json.Marshal(NewGameSnapshot(game))
+	require.NoError(t, err)
+	var snapshot GameSnapshot
+	require.NoError(t, json.Unmarshal(data, &snapshot))
+	restored := snapshot.ToGame()
+
+	require.Equal(t, game.Id(), restored.Id())
+	require.Equal(t, game.Order(), restored.Order())
+	require.Equal(t, game.Round(), restored.Round())
+	require.Equal(t, game.ScoreThreshold, restored.ScoreThreshold)
+	require.Equal(t, game.ScoreSheet, restored.ScoreSheet)
+	require.Equal(t, game.DrawDeck, restored.DrawDeck)
+	require.Equal(t, game.DiscardDeck, restored.DiscardDeck)
+	require.Equal(t, game.SelectedCard, restored.SelectedCard)
+	require.Equal(t, game.FromDiscard, restored.FromDiscard)
+	require.Equal(t, game.ShouldFlip, restored.ShouldFlip)
+	require.Equal(t, game.FinisherId, restored.FinisherId)
+	require.Equal(t, game.OpeningFlips, restored.OpeningFlips)
+	for _, player := range game.Players() {
+		restoredPlayer, found := restored.Player(player.Id())
+		require.True(t, found)
+		require.Equal(t, player.Score(), restoredPlayer.Score())
+		board, _ := game.GetBoard(player.Id())
+		restoredBoard, found := restored.GetBoard(player.Id())
+		require.True(t, found)
+		require.Equal(t, board, restoredBoard)
+	}
+
+	// the random generator goes on where it stopped
+	require.Equal(t, game.Random().Int63(), restored.Random().Int63())
+
+	// the lowest score still ranks first
+	restored.RankPlayers()
+	require.Equal(t, share_model.PlayerRank(1), restored.MustPlayer(playerB.Id()).Rank())
+	require.Equal(t, share_model.PlayerRank(2), restored.MustPlayer(playerA.Id()).Rank())
+}
+
+// newTestBoard builds a face down board whose cards add up to total, without any skyjo column.
+func newTestBoard(total int) *PlayerBoard {
+	board := NewPlayerBoard()
+	for columnIndex := 0; columnIndex < NbColumn; columnIndex++ {
+		column := NewPlayerColumn(columnIndex + 1)
+		for rowIndex := 0; rowIndex < NbRow; rowIndex++ {
+			card := Card(0)
+			switch {
+			case columnIndex == 0 && rowIndex == 0:
+				card = Card(total)
+			case rowIndex == 1:
+				card = Card(1 - 2*(columnIndex%2))
+			}
+			column.AddCell(NewPlayerCell(columnIndex+1, rowIndex+1, card))
+		}
+		board.AddColumn(column)
+	}
+	return board
+}
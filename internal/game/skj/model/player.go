@@ -6,10 +6,10 @@ import (
 
 func NewPlayerFromUser(gameId share_model.GameId, user share_model.User) *Player {
 	return &Player{
-		Player: share_model.NewPlayerFromUser(gameId, user),
+		BasePlayer: share_model.NewPlayerFromUser(gameId, user),
 	}
 }
 
 type Player struct {
-	share_model.Player
+	*share_model.BasePlayer
 }
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"strings"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// score sheet
+
+const (
+	DefaultScoreThreshold = 100
+)
+
+// RoundScore is the breakdown of one deal.
+type RoundScore struct {
+	Number     int                          `json:"number"`
+	FinisherId share_model.PlayerId         `json:"finisher-id"`
+	Doubled    bool                         `json:"doubled,omitempty"`
+	Scores     map[share_model.PlayerId]int `json:"scores"`
+}
+
+func (r RoundScore) Score(playerId share_model.PlayerId) int {
+	return r.Scores[playerId]
+}
+
+func (r RoundScore) PlayerLabels(playerId share_model.PlayerId) string {
+	labels := make([]string, 0)
+	labels = append(labels, "round-score")
+	if playerId == r.FinisherId {
+		labels = append(labels, "finisher")
+		if r.Doubled {
+			labels = append(labels, "doubled")
+		}
+	}
+	return strings.Join(labels, " ")
+}
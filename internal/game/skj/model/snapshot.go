@@ -0,0 +1,162 @@
+package model
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// game snapshot
+
+type GameSnapshot struct {
+	Game           share_model.GameSnapshot                     `json:"game"`
+	Players        []PlayerSnapshot                             `json:"players"`
+	NbRow          int                                          `json:"nb-row"`
+	NbColumn       int                                          `json:"nb-column"`
+	ScoreThreshold int                                          `json:"score-threshold"`
+	ScoreSheet     []RoundScore                                 `json:"score-sheet"`
+	DrawDeck       CardDeck                                     `json:"draw-deck"`
+	DiscardDeck    CardDeck                                     `json:"discard-deck"`
+	SelectedCard   *Card                                        `json:"selected-card,omitempty"`
+	FromDiscard    bool                                         `json:"from-discard,omitempty"`
+	ShouldFlip     bool                                         `json:"should-flip"`
+	FinisherId     share_model.PlayerId                         `json:"finisher-id,omitempty"`
+	OpeningFlips   map[share_model.PlayerId]int                 `json:"opening-flips,omitempty"`
+	Boards         map[share_model.PlayerId]PlayerBoardSnapshot `json:"boards"`
+}
+
+func NewGameSnapshot(game *Game) *GameSnapshot {
+	players := game.Players()
+	snapshot := &GameSnapshot{
+		Game:           game.Snapshot(),
+		Players:        make([]PlayerSnapshot, 0, len(players)),
+		NbRow:          game.NbRow,
+		NbColumn:       game.NbColumn,
+		ScoreThreshold: game.ScoreThreshold,
+		ScoreSheet:     game.ScoreSheet,
+		DrawDeck:       game.DrawDeck,
+		DiscardDeck:    game.DiscardDeck,
+		SelectedCard:   game.SelectedCard,
+		FromDiscard:    game.FromDiscard,
+		ShouldFlip:     game.ShouldFlip,
+		FinisherId:     game.FinisherId,
+		OpeningFlips:   game.OpeningFlips,
+		Boards:         make(map[share_model.PlayerId]PlayerBoardSnapshot, len(game.boards)),
+	}
+	for _, player := range players {
+		snapshot.Players = append(snapshot.Players, NewPlayerSnapshot(player))
+	}
+	for playerId, board := range game.boards {
+		snapshot.Boards[playerId] = NewPlayerBoardSnapshot(board)
+	}
+	return snapshot
+}
+
+func (s *GameSnapshot) ToGame() *Game {
+	players := make([]*Player, 0, len(s.Players))
+	for _, player := range s.Players {
+		players = append(players, player.ToPlayer())
+	}
+	game := &Game{
+		BaseGame:       share_model.NewGameFromSnapshot(s.Game, players),
+		NbRow:          s.NbRow,
+		NbColumn:       s.NbColumn,
+		ScoreThreshold: s.ScoreThreshold,
+		ScoreSheet:     s.ScoreSheet,
+		DrawDeck:       s.DrawDeck,
+		DiscardDeck:    s.DiscardDeck,
+		SelectedCard:   s.SelectedCard,
+		FromDiscard:    s.FromDiscard,
+		ShouldFlip:     s.ShouldFlip,
+		FinisherId:     s.FinisherId,
+		OpeningFlips:   s.OpeningFlips,
+		boards:         make(map[share_model.PlayerId]*PlayerBoard, len(s.Boards)),
+	}
+	game.SetLowestScoreFirst(true)
+	if game.ScoreSheet == nil {
+		game.ScoreSheet = make([]RoundScore, 0)
+	}
+	if game.DrawDeck == nil {
+		game.DrawDeck = CardDeck{}
+	}
+	if game.DiscardDeck == nil {
+		game.DiscardDeck = CardDeck{}
+	}
+	for playerId, board := range s.Boards {
+		game.boards[playerId] = board.ToPlayerBoard()
+	}
+	return game
+}
+
+// //////////////////////////////////////////////////
+// player snapshot
+
+type PlayerSnapshot struct {
+	Player share_model.PlayerSnapshot `json:"player"`
+}
+
+func NewPlayerSnapshot(player *Player) PlayerSnapshot {
+	return PlayerSnapshot{
+		Player: player.Snapshot(),
+	}
+}
+
+func (s PlayerSnapshot) ToPlayer() *Player {
+	return &Player{
+		BasePlayer: share_model.NewPlayerFromSnapshot(s.Player),
+	}
+}
+
+// //////////////////////////////////////////////////
+// board snapshot
+
+type PlayerBoardSnapshot struct {
+	Columns []PlayerColumnSnapshot `json:"columns"`
+}
+
+type PlayerColumnSnapshot struct {
+	Number int                  `json:"number"`
+	Cells  []PlayerCellSnapshot `json:"cells"`
+}
+
+type PlayerCellSnapshot struct {
+	Column  int  `json:"column"`
+	Row     int  `json:"row"`
+	Card    Card `json:"card"`
+	Flipped bool `json:"flipped"`
+}
+
+func NewPlayerBoardSnapshot(board *PlayerBoard) PlayerBoardSnapshot {
+	snapshot := PlayerBoardSnapshot{
+		Columns: make([]PlayerColumnSnapshot, 0, len(board.columns)),
+	}
+	for _, column := range board.columns {
+		columnSnapshot := PlayerColumnSnapshot{
+			Number: column.columnNumber,
+			Cells:  make([]PlayerCellSnapshot, 0, len(column.cells)),
+		}
+		for _, cell := range column.cells {
+			columnSnapshot.Cells = append(columnSnapshot.Cells, PlayerCellSnapshot{
+				Column:  cell.columnNumber,
+				Row:     cell.rowNumber,
+				Card:    cell.card,
+				Flipped: cell.flipped,
+			})
+		}
+		snapshot.Columns = append(snapshot.Columns, columnSnapshot)
+	}
+	return snapshot
+}
+
+func (s PlayerBoardSnapshot) ToPlayerBoard() *PlayerBoard {
+	board := NewPlayerBoard()
+	for _, columnSnapshot := range s.Columns {
+		column := NewPlayerColumn(columnSnapshot.Number)
+		for _, cellSnapshot := range columnSnapshot.Cells {
+			cell := NewPlayerCell(cellSnapshot.Column, cellSnapshot.Row, cellSnapshot.Card)
+			cell.flipped = cellSnapshot.Flipped
+			column.AddCell(cell)
+		}
+		board.AddColumn(column)
+	}
+	return board
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	share_service "github.com/gre-ory/games-go/internal/game/share/service"
+
+	"github.com/gre-ory/games-go/internal/game/skj/model"
+)
+
+// //////////////////////////////////////////////
+// bot
+
+const (
+	// faceDownCardValue is about the average value of a face down card.
+	faceDownCardValue = 5
+	// lowCardValue is the highest card worth putting in place of a face down card.
+	lowCardValue = 3
+)
+
+type botAction = share_service.BotAction[*model.Player, *model.Game]
+
+// NewBot returns a greedy bot: it keeps any card lowering its visible score and flips the others away.
+func NewBot(onReshuffle func(game *model.Game)) share_service.Bot[*model.Player, *model.Game] {
+	return &bot{
+		onReshuffle: onReshuffle,
+	}
+}
+
+type bot struct {
+	onReshuffle func(game *model.Game)
+}
+
+func (b *bot) NextAction(game *model.Game, player *model.Player) (botAction, error) {
+	board, err := getBoard(game, player)
+	if err != nil {
+		return botAction{}, err
+	}
+
+	switch {
+	case game.IsOpening(), game.ShouldFlip:
+		cell, found := firstFaceDownCell(board)
+		if !found {
+			return botAction{}, model.ErrCardAlreadyFlipped
+		}
+		return flipCardAction(cell.Column(), cell.Row()), nil
+
+	case game.SelectedCard != nil:
+		cell, found := bestCell(board, *game.SelectedCard)
+		if !found {
+			if _, faceDown := firstFaceDownCell(board); faceDown && game.CanDiscard() {
+				return botAction{
+					EventType: model.EventType_DiscardCard,
+					PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+						return discardCard(game)
+					},
+				}, nil
+			}
+			// nothing left to flip: the card has to go somewhere
+			cell = leastBadCell(board, *game.SelectedCard)
+		}
+		return putCardAction(cell.Column(), cell.Row()), nil
+
+	default:
+		if top, err := game.DiscardDeck.GetTopCard(); err == nil {
+			if _, found := bestCell(board, top); found {
+				return botAction{
+					EventType: model.EventType_DrawDiscardCard,
+					PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+						return drawDiscardCard(game)
+					},
+				}, nil
+			}
+		}
+		return botAction{
+			EventType: model.EventType_DrawCard,
+			PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+				reshuffled, err := drawCard(game)
+				if reshuffled && b.onReshuffle != nil {
+					b.onReshuffle(game)
+				}
+				return false, err
+			},
+		}, nil
+	}
+}
+
+func flipCardAction(columnNumber, rowNumber int) botAction {
+	return botAction{
+		EventType: model.EventType_FlipCard,
+		Payload:   model.CellPayload{Column: columnNumber, Row: rowNumber},
+		PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+			return flipCard(game, player, columnNumber, rowNumber)
+		},
+	}
+}
+
+func putCardAction(columnNumber, rowNumber int) botAction {
+	return botAction{
+		EventType: model.EventType_PutCard,
+		Payload:   model.CellPayload{Column: columnNumber, Row: rowNumber},
+		PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+			return putCard(game, player, columnNumber, rowNumber)
+		},
+	}
+}
+
+func firstFaceDownCell(board *model.PlayerBoard) (*model.PlayerCell, bool) {
+	for _, column := range board.Columns() {
+		for _, cell := range column.Cells() {
+			if cell.CanFlip() {
+				return cell, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// bestCell returns the cell where putting the card lowers the score the most, if any.
+func bestCell(board *model.PlayerBoard, card model.Card) (*model.PlayerCell, bool) {
+	var best *model.PlayerCell
+	bestGain := 0
+	for _, column := range board.Columns() {
+		for _, cell := range column.Cells() {
+			if gain := putGain(column, cell, card); gain > bestGain {
+				best, bestGain = cell, gain
+			}
+		}
+	}
+	return best, best != nil
+}
+
+// leastBadCell returns the cell where putting the card raises the score the least.
+func leastBadCell(board *model.PlayerBoard, card model.Card) *model.PlayerCell {
+	var best *model.PlayerCell
+	bestGain := 0
+	for _, column := range board.Columns() {
+		for _, cell := range column.Cells() {
+			if gain := putGain(column, cell, card); best == nil || gain > bestGain {
+				best, bestGain = cell, gain
+			}
+		}
+	}
+	return best
+}
+
+// putGain is how much putting the card in the cell is expected to lower the score.
+func putGain(column *model.PlayerColumn, cell *model.PlayerCell, card model.Card) int {
+	value := int(card)
+
+	//
+	// a column of three identical cards is taken off the board
+	//
+
+	sameCards, otherTotal := 0, 0
+	for _, other := range column.Cells() {
+		if other == cell {
+			continue
+		}
+		if other.IsFlipped() && other.Card() == value {
+			sameCards++
+			otherTotal += other.Card()
+		}
+	}
+	if sameCards == len(column.Cells())-1 {
+		return cellValue(cell) + otherTotal
+	}
+
+	if !cell.IsFlipped() {
+		if value > lowCardValue {
+			return 0
+		}
+		return faceDownCardValue - value
+	}
+	return cell.Card() - value
+}
+
+func cellValue(cell *model.PlayerCell) int {
+	if !cell.IsFlipped() {
+		return faceDownCardValue
+	}
+	return cell.Card()
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+	share_store "github.com/gre-ory/games-go/internal/game/share/store"
+
+	"github.com/gre-ory/games-go/internal/game/skj/model"
+	"github.com/gre-ory/games-go/internal/game/skj/store"
+)
+
+// newTestBoard builds a board from its columns, nil cards being face down.
+func newTestBoard(columns ...[]*int) *model.PlayerBoard {
+	board := model.NewPlayerBoard()
+	for columnIndex, cards := range columns {
+		column := model.NewPlayerColumn(columnIndex + 1)
+		for rowIndex, card := range cards {
+			if card == nil {
+				column.AddCell(model.NewPlayerCell(columnIndex+1, rowIndex+1, model.Card(faceDownCardValue)))
+				continue
+			}
+			column.AddCell(model.NewPlayerCell(columnIndex+1, rowIndex+1, model.Card(*card)))
+		}
+		board.AddColumn(column)
+	}
+	for columnIndex, cards := range columns {
+		for rowIndex, card := range cards {
+			if card != nil {
+				if err := board.Flip(columnIndex, rowIndex); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
+	return board
+}
+
+func card(value int) *int {
+	return &value
+}
+
+func TestBotBestCell(t *testing.T) {
+
+	type TestCase struct {
+		board      *model.PlayerBoard
+		card       model.Card
+		wantFound  bool
+		wantColumn int
+		wantRow    int
+	}
+
+	testCases := map[string]TestCase{
+		"replace-highest": {
+			board:      newTestBoard([]*int{card(4), card(12), nil}, []*int{card(9), nil, nil}),
+			card:       2,
+			wantFound:  true,
+			wantColumn: 1,
+			wantRow:    2,
+		},
+		"keep-low-face-down": {
+			board:      newTestBoard([]*int{card(1), card(0), nil}, []*int{card(-1), nil, nil}),
+			card:       -2,
+			wantFound:  true,
+			wantColumn: 1,
+			wantRow:    3,
+		},
+		"complete-column": {
+			board:      newTestBoard([]*int{card(7), card(7), card(11)}, []*int{card(12), nil, nil}),
+			card:       7,
+			wantFound:  true,
+			wantColumn: 1,
+			wantRow:    3,
+		},
+		"nothing-better": {
+			board: newTestBoard([]*int{card(1), card(2), nil}, []*int{card(3), nil, nil}),
+			card:  8,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cell, found := bestCell(tc.board, tc.card)
+			require.Equal(t, tc.wantFound, found)
+			if !found {
+				return
+			}
+			require.Equal(t, tc.wantColumn, cell.Column())
+			require.Equal(t, tc.wantRow, cell.Row())
+		})
+	}
+}
+
+func TestBotGame(t *testing.T) {
+
+	service := NewGameService(zap.NewNop(), store.NewGameStore())
+	service.SetBotDelay(-1)
+	eventStore := share_store.NewEventMemoryStore()
+	service.RegisterOnEvent(func(event share_model.Event) {
+		require.NoError(t, eventStore.Append(event))
+	})
+
+	user := share_model.NewUser("U1")
+	game, err := service.CreateGameWithSettings(user, share_model.GameOptions{}, model.Settings{ScoreThreshold: 30})
+	require.NoError(t, err)
+	human := game.MustPlayer(share_model.NewPlayerId(game.Id(), user.Id()))
+	_, err = service.AddBot(human)
+	require.NoError(t, err)
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+
+	// the human plays as a bot would
+	humanBot := NewBot(nil)
+	for nbAction := 0; !game.IsStopped(); nbAction++ {
+		require.Less(t, nbAction, 10000)
+		if human.IsPlaying() {
+			action, err := humanBot.NextAction(game, human)
+			require.NoError(t, err)
+			_, err = service.Play(human, game.Version(), action.EventType, action.Payload, action.PlayFn)
+			require.NoError(t, err)
+		} else {
+			_, err = service.PlayBot(game.Id())
+			require.NoError(t, err)
+		}
+	}
+
+	events, err := eventStore.List(game.Id())
+	require.NoError(t, err)
+	replayed, err := service.ReplayGame(events)
+	require.NoError(t, err)
+	require.Equal(t, game.ScoreSheet, replayed.ScoreSheet)
+	for _, player := range game.Players() {
+		require.Equal(t, player.Result(), replayed.MustPlayer(player.Id()).Result())
+	}
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"fmt"
+	"sort"
+
 	"go.uber.org/zap"
 
 	share_model "github.com/gre-ory/games-go/internal/game/share/model"
@@ -13,131 +16,333 @@ import (
 type GameService interface {
 	share_service.GameService[*model.Player, *model.Game]
 
-	DrawDiscardCard(player *model.Player) (*model.Game, error)
-	DrawCard(player *model.Player) (*model.Game, error)
-	PutCard(player *model.Player, columnNumber, rowNumber int) (*model.Game, error)
-	DiscardCard(player *model.Player) (*model.Game, error)
-	FlipCard(player *model.Player, columnNumber, rowNumber int) (*model.Game, error)
+	DrawDiscardCard(player *model.Player, version int) (*model.Game, error)
+	DrawCard(player *model.Player, version int) (*model.Game, error)
+	PutCard(player *model.Player, version, columnNumber, rowNumber int) (*model.Game, error)
+	DiscardCard(player *model.Player, version int) (*model.Game, error)
+	FlipCard(player *model.Player, version, columnNumber, rowNumber int) (*model.Game, error)
+
+	RegisterOnReshuffle(func(game *model.Game))
+
+	ReplayGame(events []share_model.Event) (*model.Game, error)
 }
 
 func NewGameService(logger *zap.Logger, gameStore store.GameStore) GameService {
-	plugin := NewGamePlugin()
-	return &gameService{
+	var service *gameService
+	plugin := NewGamePlugin(func(game *model.Game) {
+		service.onReshuffle(game)
+	})
+	service = &gameService{
 		GameService: share_service.NewGameService(logger, plugin, gameStore),
 		logger:      logger,
 	}
+	service.SetBot(NewBot(service.onReshuffle))
+	return service
 }
 
 type gameService struct {
 	share_service.GameService[*model.Player, *model.Game]
-	logger *zap.Logger
+	logger         *zap.Logger
+	onReshuffleFns []func(game *model.Game)
 }
 
-func (s *gameService) DrawDiscardCard(player *model.Player) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
+func (s *gameService) DrawDiscardCard(player *model.Player, version int) (*model.Game, error) {
+	return s.Play(player, version, model.EventType_DrawDiscardCard, nil, func(game *model.Game, player *model.Player) (bool, error) {
+		return drawDiscardCard(game)
+	})
+}
+
+func (s *gameService) DrawCard(player *model.Player, version int) (*model.Game, error) {
+	reshuffled := false
+	game, err := s.Play(player, version, model.EventType_DrawCard, nil, func(game *model.Game, player *model.Player) (bool, error) {
+		var err error
+		reshuffled, err = drawCard(game)
+		return false, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	if game.SelectedCard != nil {
-		return nil, model.ErrAlreadySelectedCard
+	if reshuffled {
+		s.onReshuffle(game)
+	}
+	return game, nil
+}
+
+func (s *gameService) PutCard(player *model.Player, version, columnNumber, rowNumber int) (*model.Game, error) {
+	payload := model.CellPayload{Column: columnNumber, Row: rowNumber}
+	return s.Play(player, version, model.EventType_PutCard, payload, func(game *model.Game, player *model.Player) (bool, error) {
+		return putCard(game, player, columnNumber, rowNumber)
+	})
+}
+
+func (s *gameService) DiscardCard(player *model.Player, version int) (*model.Game, error) {
+	return s.Play(player, version, model.EventType_DiscardCard, nil, func(game *model.Game, player *model.Player) (bool, error) {
+		return discardCard(game)
+	})
+}
+
+func (s *gameService) FlipCard(player *model.Player, version, columnNumber, rowNumber int) (*model.Game, error) {
+	payload := model.CellPayload{Column: columnNumber, Row: rowNumber}
+	return s.Play(player, version, model.EventType_FlipCard, payload, func(game *model.Game, player *model.Player) (bool, error) {
+		return flipCard(game, player, columnNumber, rowNumber)
+	})
+}
+
+// //////////////////////////////////////////////
+// play
+
+func drawDiscardCard(game *model.Game) (bool, error) {
+	if game.IsOpening() {
+		return false, model.ErrOpeningNotOver
+	}
+	if game.SelectedCard != nil || game.ShouldFlip {
+		return false, model.ErrAlreadySelectedCard
 	}
 	card, err := game.DiscardDeck.Draw()
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	game.SelectedCard = &card
-	return game, nil
+	game.FromDiscard = true
+	return false, nil
 }
 
-func (s *gameService) DrawCard(player *model.Player) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
-	if err != nil {
-		return nil, err
+// drawCard tells whether the discard pile had to be recycled into a new draw pile.
+func drawCard(game *model.Game) (bool, error) {
+	if game.IsOpening() {
+		return false, model.ErrOpeningNotOver
 	}
-	if game.SelectedCard != nil {
-		return nil, model.ErrAlreadySelectedCard
+	if game.SelectedCard != nil || game.ShouldFlip {
+		return false, model.ErrAlreadySelectedCard
 	}
+
+	//
+	// recycle the discard pile once the draw pile is exhausted
+	//
+
+	reshuffled := false
+	if game.DrawDeck.IsEmpty() {
+		if err := game.RecycleDiscardDeck(); err != nil {
+			return false, err
+		}
+		reshuffled = true
+	}
+
 	card, err := game.DrawDeck.Draw()
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	game.SelectedCard = &card
-	return game, nil
+	game.FromDiscard = false
+	return reshuffled, nil
 }
 
-func (s *gameService) PutCard(player *model.Player, columnNumber, rowNumber int) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
-	if err != nil {
-		return nil, err
-	}
+func putCard(game *model.Game, player *model.Player, columnNumber, rowNumber int) (bool, error) {
 	if game.SelectedCard == nil {
-		return nil, model.ErrMissingSelectedCard
+		return false, model.ErrMissingSelectedCard
 	}
-	board, err := s.getBoard(game, player)
+	board, err := getBoard(game, player)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	cardToDiscard, err := board.Put(*game.SelectedCard, columnNumber-1, rowNumber-1)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	game.DiscardDeck.Add(cardToDiscard)
-	return game, nil
+	return endTurn(game, player, board)
 }
 
-func (s *gameService) DiscardCard(player *model.Player) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
-	if err != nil {
-		return nil, err
-	}
+func discardCard(game *model.Game) (bool, error) {
 	if game.SelectedCard == nil {
-		return nil, model.ErrMissingSelectedCard
+		return false, model.ErrMissingSelectedCard
+	}
+	if game.FromDiscard {
+		return false, model.ErrMustPutCard
 	}
 	game.DiscardDeck.Add(*game.SelectedCard)
+	game.SelectedCard = nil
 	game.ShouldFlip = true
-	return game, nil
+	return false, nil
 }
 
-func (s *gameService) FlipCard(player *model.Player, columnNumber, rowNumber int) (*model.Game, error) {
-	game, err := s.getPlayGame(player)
+func flipCard(game *model.Game, player *model.Player, columnNumber, rowNumber int) (bool, error) {
+	board, err := getBoard(game, player)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	if !game.ShouldFlip {
-		return nil, model.ErrNotShouldFlip
+	if game.IsOpening() {
+		return false, openingFlip(game, player, func() error {
+			return board.Flip(columnNumber-1, rowNumber-1)
+		})
 	}
-	board, err := s.getBoard(game, player)
-	if err != nil {
-		return nil, err
+	if !game.ShouldFlip {
+		return false, model.ErrNotShouldFlip
 	}
 	err = board.Flip(columnNumber-1, rowNumber-1)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
+	return endTurn(game, player, board)
+}
 
-	// check if all cells are flipped
-	if board.IsFlipped() {
+// endTurn hands the turn over to the next player, deals a new round once the last turn is over, and tells whether the game is over.
+func endTurn(game *model.Game, player *model.Player, board *model.PlayerBoard) (bool, error) {
+	game.SelectedCard = nil
+	game.FromDiscard = false
+	game.ShouldFlip = false
 
+	//
+	// completed columns go to the discard pile
+	//
+
+	for _, card := range board.RemoveSkyjoColumns() {
+		game.DiscardDeck.Add(card)
 	}
 
-	return game, nil
+	//
+	// first fully flipped board triggers the last turn
+	//
+
+	if !game.HasFinisher() && board.IsFlipped() {
+		game.FinisherId = player.Id()
+	}
+
+	game.NextRound()
+	if game.IsLastTurnOver() {
+		game.ScoreRound()
+		if game.IsOver() {
+			game.SetResults()
+			return true, nil
+		}
+
+		//
+		// the finisher starts the next round
+		//
+
+		game.NewDeal()
+		if err := dealBoards(game); err != nil {
+			return false, err
+		}
+		game.StartOpening()
+		return false, nil
+	}
+
+	game.SetPlayingRoundPlayer()
+	return false, nil
 }
 
-func (s *gameService) getPlayGame(player *model.Player) (*model.Game, error) {
-	game, err := s.GetGame(player.GameId())
+// openingFlip counts an opening card of the player and starts the turns once every player flipped theirs.
+func openingFlip(game *model.Game, player *model.Player, flipFn func() error) error {
+	if game.OpeningFlips[player.Id()] >= model.NbOpeningFlip {
+		return model.ErrOpeningFlipsDone
+	}
+	if err := flipFn(); err != nil {
+		return err
+	}
+	game.OpeningFlips[player.Id()]++
+	if game.OpeningFlips[player.Id()] >= model.NbOpeningFlip {
+		player.SetStatus(share_model.PlayerStatus_WaitingToPlay)
+	}
+
+	if game.IsOpeningOver() {
+		game.StopOpening()
+		game.SetPlayingRoundPlayer()
+	}
+	return nil
+}
+
+// dealBoards builds a face down board for each player and starts the discard pile.
+// Players are dealt by id, so that replays of the same game deal the same boards.
+func dealBoards(game *model.Game) error {
+	players := game.Players()
+	sort.Slice(players, func(i, j int) bool { return players[i].Id() < players[j].Id() })
+	for _, player := range players {
+		board := model.NewPlayerBoard()
+		for columnIndex := 0; columnIndex < game.NbColumn; columnIndex++ {
+			column := model.NewPlayerColumn(columnIndex + 1)
+			for rowIndex := 0; rowIndex < game.NbRow; rowIndex++ {
+				card, err := game.DrawDeck.Draw()
+				if err != nil {
+					return err
+				}
+				cell := model.NewPlayerCell(columnIndex+1, rowIndex+1, card)
+				column.AddCell(cell)
+			}
+			board.AddColumn(column)
+		}
+		game.AddBoard(player.Id(), board)
+	}
+
+	card, err := game.DrawDeck.Draw()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if err := game.Status().CanPlay(); err != nil {
-		return nil, err
+	game.DiscardDeck.Add(card)
+	return nil
+}
+
+// //////////////////////////////////////////////
+// callbacks
+
+func (s *gameService) RegisterOnReshuffle(onReshuffleFn func(game *model.Game)) {
+	s.onReshuffleFns = append(s.onReshuffleFns, onReshuffleFn)
+}
+
+func (s *gameService) onReshuffle(game *model.Game) {
+	s.logger.Info(fmt.Sprintf("[reshuffle] game %s >>> DISCARD PILE RECYCLED", game.Id()))
+	for _, onReshuffleFn := range s.onReshuffleFns {
+		onReshuffleFn(game)
 	}
-	if !player.Status().IsPlaying() {
-		return nil, share_model.ErrWrongPlayer
+}
+
+// //////////////////////////////////////////////
+// replay
+
+// ReplayGame rebuilds a game from its recorded events in a separate in-memory service.
+// Replayed reshuffles are not notified.
+func (s *gameService) ReplayGame(events []share_model.Event) (*model.Game, error) {
+	return share_service.Replay(s.logger, NewGamePlugin(nil), events, replayEvent)
+}
+
+// replayEvent turns a recorded event into the action it played.
+func replayEvent(event share_model.Event) (func(game *model.Game, player *model.Player) (bool, error), error) {
+	switch event.Type {
+	case model.EventType_DrawDiscardCard:
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return drawDiscardCard(game)
+		}, nil
+	case model.EventType_DrawCard:
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			_, err := drawCard(game)
+			return false, err
+		}, nil
+	case model.EventType_PutCard:
+		var payload model.CellPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return nil, err
+		}
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return putCard(game, player, payload.Column, payload.Row)
+		}, nil
+	case model.EventType_DiscardCard:
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return discardCard(game)
+		}, nil
+	case model.EventType_FlipCard:
+		var payload model.CellPayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return nil, err
+		}
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return flipCard(game, player, payload.Column, payload.Row)
+		}, nil
+	default:
+		return nil, share_model.ErrUnknownEvent
 	}
-	return game, nil
 }
 
-func (s *gameService) getBoard(game *model.Game, player *model.Player) (*model.PlayerBoard, error) {
+func getBoard(game *model.Game, player *model.Player) (*model.PlayerBoard, error) {
 	if board, found := game.GetBoard(player.Id()); found {
 		return board, nil
 	}
@@ -147,18 +352,25 @@ func (s *gameService) getBoard(game *model.Game, player *model.Player) (*model.P
 // //////////////////////////////////////////////
 // game plugin
 
-func NewGamePlugin() share_service.GamePlugin[*model.Player, *model.Game] {
-	return &gamePlugin{}
+func NewGamePlugin(onReshuffle func(game *model.Game)) share_service.GamePlugin[*model.Player, *model.Game] {
+	return &gamePlugin{
+		onReshuffle: onReshuffle,
+	}
 }
 
-type gamePlugin struct{}
+type gamePlugin struct {
+	onReshuffle func(game *model.Game)
+}
 
 func (p *gamePlugin) CanCreateGame(user share_model.User) error {
 	return nil
 }
 
-func (p *gamePlugin) CreateGame(user share_model.User) (*model.Game, *model.Player, error) {
-	game := model.NewGame(3, 3)
+func (p *gamePlugin) CreateGame(user share_model.User, setup share_model.GameSetup) (*model.Game, *model.Player, error) {
+	game, err := model.NewGameFromSetup(setup, model.NbRow, model.NbColumn)
+	if err != nil {
+		return nil, nil, err
+	}
 	player := model.NewPlayerFromUser(game.Id(), user)
 	return game, player, nil
 }
@@ -185,35 +397,18 @@ func (p *gamePlugin) StartGame(game *model.Game) (*model.Game, error) {
 	// draw cards & build player boards
 	//
 
-	for _, player := range game.Players() {
-		board := model.NewPlayerBoard()
-		for columnIndex := 0; columnIndex < game.NbColumn; columnIndex++ {
-			column := model.NewPlayerColumn(columnIndex + 1)
-			for rowIndex := 0; rowIndex < game.NbRow; rowIndex++ {
-				card, err := game.DrawDeck.Draw()
-				if err != nil {
-					return nil, err
-				}
-				cell := model.NewPlayerCell(columnIndex+1, rowIndex+1, card)
-				column.AddCell(cell)
-			}
-			board.AddColumn(column)
-		}
-		game.AddBoard(player.Id(), board)
+	if err := dealBoards(game); err != nil {
+		return nil, err
 	}
 
 	//
-	// set random order
-	//
-
-	game.SetRandomOrder()
-
-	//
-	// set first playing player
+	// seat players ( rotated from the previous game of the table on a rematch ), the opening picks who starts
 	//
 
-	game.FirstRound()
-	game.SetPlayingRoundPlayer()
+	if !game.SetRematchOrder() {
+		game.SetOrder(seatingOrder(game))
+	}
+	game.StartOpening()
 
 	return game, nil
 }
@@ -251,3 +446,97 @@ func (p *gamePlugin) LeaveGame(game *model.Game, player *model.Player) (*model.G
 func (p *gamePlugin) CanDeleteGame(game *model.Game, playerId share_model.PlayerId) error {
 	return nil
 }
+
+// PlayDefault flips the missing opening cards, or ends the turn of the player with the least committal move:
+// the drawn card is discarded & the first face down card flipped, the card replacing the first cell only once every card is face up.
+// A card taken from the discard pile is put where it costs the least.
+// The move is checked before drawing, so that a player left without any card forfeits with the game untouched.
+func (p *gamePlugin) PlayDefault(game *model.Game, player *model.Player) (bool, error) {
+	if game.IsOpening() {
+		return false, playDefaultOpening(game)
+	}
+
+	board, err := getBoard(game, player)
+	if err != nil {
+		return false, err
+	}
+	cell, faceDown := firstFaceDownCell(board)
+	if !faceDown && (len(board.Columns()) == 0 || game.ShouldFlip) {
+		// no card left to flip nor to replace: forfeit
+		return false, share_model.ErrNoDefaultAction
+	}
+
+	//
+	// draw a card, unless already done
+	//
+
+	if game.SelectedCard == nil && !game.ShouldFlip {
+		reshuffled, err := drawCard(game)
+		if err != nil {
+			// no card left to draw: forfeit
+			return false, share_model.ErrNoDefaultAction
+		}
+		if reshuffled && p.onReshuffle != nil {
+			p.onReshuffle(game)
+		}
+	}
+
+	//
+	// put a card taken from the discard pile where it costs the least
+	//
+
+	if game.FromDiscard {
+		cell, found := bestCell(board, *game.SelectedCard)
+		if !found {
+			cell = leastBadCell(board, *game.SelectedCard)
+		}
+		return putCard(game, player, cell.Column(), cell.Row())
+	}
+
+	//
+	// discard & flip the first face down card, or replace the first cell
+	//
+
+	if !faceDown {
+		return putCard(game, player, 1, 1)
+	}
+	if game.SelectedCard != nil {
+		if _, err := discardCard(game); err != nil {
+			return false, err
+		}
+	}
+	return flipCard(game, player, cell.Column(), cell.Row())
+}
+
+// playDefaultOpening flips the missing opening cards of every late player, once every board is found.
+func playDefaultOpening(game *model.Game) error {
+	players := game.PlayingPlayers()
+	for _, player := range players {
+		if _, found := game.GetBoard(player.Id()); !found {
+			return model.ErrPlayerBoardNotFound
+		}
+	}
+	for _, player := range players {
+		board := game.Board(player.Id())
+		for game.IsOpening() && game.OpeningFlips[player.Id()] < model.NbOpeningFlip {
+			if err := openingFlip(game, player, board.FlipFirst); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// seatingOrder seats players by id, so that replays of the same game seat them the same way.
+func seatingOrder(game *model.Game) [][]share_model.PlayerId {
+	playerIds := make([]share_model.PlayerId, 0, game.NbPlayer())
+	for _, player := range game.Players() {
+		playerIds = append(playerIds, player.Id())
+	}
+	sort.Slice(playerIds, func(i, j int) bool { return playerIds[i] < playerIds[j] })
+	order := make([][]share_model.PlayerId, 0, len(playerIds))
+	for _, playerId := range playerIds {
+		order = append(order, []share_model.PlayerId{playerId})
+	}
+	return order
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+
+	"github.com/gre-ory/games-go/internal/game/skj/model"
+	"github.com/gre-ory/games-go/internal/game/skj/store"
+)
+
+func TestTurnTimeout(t *testing.T) {
+
+	service := NewGameService(zap.NewNop(), store.NewGameStore())
+	timeouts := make(chan share_model.Event, 1)
+	service.RegisterOnEvent(func(event share_model.Event) {
+		if event.Type == share_model.EventType_TurnTimeout {
+			select {
+			case timeouts <- event:
+			default:
+			}
+		}
+	})
+
+	game, err := service.CreateGame(share_model.NewUser("U1"), share_model.GameOptions{TurnTimeout: 2 * time.Second})
+	require.NoError(t, err)
+	for _, userId := range []share_model.UserId{"U2", "U3"} {
+		game, err = service.JoinGame(game, share_model.NewUser(userId))
+		require.NoError(t, err)
+	}
+	gameId := game.Id()
+	game, err = service.StartGame(game)
+	require.NoError(t, err)
+
+	//
+	// every player flips the top card of their first columns
+	//
+
+	for _, player := range game.Players() {
+		for column := 1; column <= model.NbOpeningFlip; column++ {
+			_, err = service.FlipCard(player, game.Version(), column, 1)
+			require.NoError(t, err)
+		}
+	}
+
+	// the game is only read once the timer goroutine is done with it
+	var event share_model.Event
+	select {
+	case event = <-timeouts:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "turn did not time out")
+	}
+
+	// the default move discards a drawn card & flips the first face down card, then the game goes on
+	game, err = service.GetGame(gameId)
+	require.NoError(t, err)
+	require.True(t, game.IsStarted())
+	require.NotEqual(t, event.PlayerId, game.RoundPlayer().Id())
+	require.Nil(t, game.SelectedCard)
+	require.False(t, game.ShouldFlip)
+	board, found := game.GetBoard(event.PlayerId)
+	require.True(t, found)
+	require.True(t, board.Columns()[0].Cells()[1].IsFlipped())
+	require.False(t, board.Columns()[0].Cells()[2].IsFlipped())
+	for _, player := range game.Players() {
+		require.False(t, player.Result().IsLoose())
+	}
+
+	_, err = service.LeavePlayerGame(game.RoundPlayer())
+	require.NoError(t, err)
+}
+
+// newTestGame builds a game past its opening, every player holding the same board.
+func newTestGame(t *testing.T, discardCard model.Card) *model.Game {
+	game := model.NewGame(share_model.GameSetup{Id: "G1", Seed: 1}, 3, 2)
+	for _, userId := range []share_model.UserId{"U1", "U2"} {
+		player := model.NewPlayerFromUser(game.Id(), share_model.NewUser(userId))
+		game.AttachPlayer(player)
+		game.AddBoard(player.Id(), newTestBoard([]*int{card(4), card(12), nil}, []*int{card(9), nil, nil}))
+	}
+	game.SetRandomOrder()
+	game.FirstRound()
+	game.SetPlayingRoundPlayer()
+	game.DiscardDeck = model.CardDeck{7, discardCard}
+	return game
+}
+
+func TestDiscardCard(t *testing.T) {
+
+	type TestCase struct {
+		fromDiscard bool
+		wantErr     error
+	}
+
+	testCases := map[string]TestCase{
+		"from-draw-pile":    {fromDiscard: false},
+		"from-discard-pile": {fromDiscard: true, wantErr: model.ErrMustPutCard},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newTestGame(t, 2)
+			var err error
+			if tc.fromDiscard {
+				_, err = drawDiscardCard(game)
+			} else {
+				_, err = drawCard(game)
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.fromDiscard, game.FromDiscard)
+			require.Equal(t, !tc.fromDiscard, game.CanDiscard())
+
+			_, err = discardCard(game)
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantErr == nil, game.ShouldFlip)
+			require.Equal(t, tc.wantErr != nil, game.HasSelectedCard())
+		})
+	}
+}
+
+func TestPlayDefaultFromDiscard(t *testing.T) {
+
+	type TestCase struct {
+		discardCard   model.Card
+		wantColumn    int
+		wantRow       int
+		wantDiscarded model.Card
+	}
+
+	testCases := map[string]TestCase{
+		"best-cell":      {discardCard: 2, wantColumn: 1, wantRow: 2, wantDiscarded: 12},
+		"least-bad-cell": {discardCard: 12, wantColumn: 1, wantRow: 2, wantDiscarded: 12},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newTestGame(t, tc.discardCard)
+			player := game.RoundPlayer()
+			round := game.Round()
+			_, err := drawDiscardCard(game)
+			require.NoError(t, err)
+
+			// the card taken from the discard pile is put, never discarded back
+			stop, err := NewGamePlugin(nil).PlayDefault(game, player)
+			require.NoError(t, err)
+			require.False(t, stop)
+			board, found := game.GetBoard(player.Id())
+			require.True(t, found)
+			cell := board.Columns()[tc.wantColumn-1].Cells()[tc.wantRow-1]
+			require.True(t, cell.IsFlipped())
+			require.Equal(t, int(tc.discardCard), cell.Card())
+			require.Equal(t, int(tc.wantDiscarded), game.TopDiscardCard())
+			require.Nil(t, game.SelectedCard)
+			require.False(t, game.FromDiscard)
+			require.False(t, game.ShouldFlip)
+			require.Equal(t, round+1, game.Round())
+		})
+	}
+}
+
+func TestPlayDefaultEmptyBoard(t *testing.T) {
+	game := newTestGame(t, 2)
+	player := game.RoundPlayer()
+	game.AddBoard(player.Id(), model.NewPlayerBoard())
+	drawDeck := append(model.CardDeck{}, game.DrawDeck...)
+
+	// every column was taken off the board: the player forfeits without drawing
+	_, err := NewGamePlugin(nil).PlayDefault(game, player)
+	require.ErrorIs(t, err, share_model.ErrNoDefaultAction)
+	require.Equal(t, drawDeck, game.DrawDeck)
+	require.Nil(t, game.SelectedCard)
+	require.True(t, game.CanDraw())
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"fmt"
+
+	share_service "github.com/gre-ory/games-go/internal/game/share/service"
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	"github.com/gre-ory/games-go/internal/game/skj/model"
+)
+
+// //////////////////////////////////////////////
+// simulation
+
+// NewSimulationConfig simulates games between bots, counting how many rounds they last.
+func NewSimulationConfig(nbPlayer int) simulation.Config[*model.Player, *model.Game] {
+	return simulation.Config[*model.Player, *model.Game]{
+		NewPlayers: simulation.NewPlayers(nbPlayer, func() share_service.Bot[*model.Player, *model.Game] {
+			return NewBot(nil)
+		}),
+		Invariants: SimulationInvariants(),
+		Outcome: func(game *model.Game) string {
+			return fmt.Sprintf("%d-round", len(game.ScoreSheet))
+		},
+	}
+}
+
+// SimulationInvariants are the rules a simulated game must keep after each action.
+func SimulationInvariants() []simulation.Invariant[*model.Player, *model.Game] {
+	return []simulation.Invariant[*model.Player, *model.Game]{
+		{
+			Name: "cards",
+			CheckFn: func(game *model.Game) error {
+				nbCard := make(map[model.Card]int)
+				for _, card := range game.DrawDeck {
+					nbCard[card]++
+				}
+				for _, card := range game.DiscardDeck {
+					nbCard[card]++
+				}
+				if game.SelectedCard != nil {
+					nbCard[*game.SelectedCard]++
+				}
+				for _, player := range game.Players() {
+					board, found := game.GetBoard(player.Id())
+					if !found {
+						continue
+					}
+					for _, column := range board.Columns() {
+						for _, cell := range column.Cells() {
+							nbCard[model.Card(cell.Card())]++
+						}
+					}
+				}
+				for card, nbPerValue := range model.Card_NbPerValue {
+					if nbCard[card] != nbPerValue {
+						return fmt.Errorf("%d cards %d instead of %d", nbCard[card], card, nbPerValue)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name: "scores",
+			CheckFn: func(game *model.Game) error {
+				for _, player := range game.Players() {
+					total := 0
+					for _, roundScore := range game.ScoreSheet {
+						total += roundScore.Score(player.Id())
+					}
+					if int(player.Score()) != total {
+						return fmt.Errorf("player %s has a score of %d instead of %d", player.Id(), player.Score(), total)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name: "turn",
+			CheckFn: func(game *model.Game) error {
+				if game.SelectedCard != nil && game.ShouldFlip {
+					return fmt.Errorf("card selected while a card should be flipped")
+				}
+				if game.FromDiscard && game.SelectedCard == nil {
+					return fmt.Errorf("card taken from the discard pile without a selected card")
+				}
+				if game.IsOpening() && (game.SelectedCard != nil || game.ShouldFlip) {
+					return fmt.Errorf("card selected during the opening")
+				}
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	"github.com/gre-ory/games-go/internal/game/skj/model"
+	"github.com/gre-ory/games-go/internal/game/skj/store"
+)
+
+func TestSimulation(t *testing.T) {
+
+	type TestCase struct {
+		nbPlayer int
+		settings model.Settings
+	}
+
+	testCases := map[string]TestCase{
+		"two-players": {
+			nbPlayer: 2,
+		},
+		"four-players": {
+			nbPlayer: 4,
+			settings: model.Settings{ScoreThreshold: 50},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := NewSimulationConfig(tc.nbPlayer)
+			config.NbGame = 50
+			config.Settings = tc.settings
+			report := simulation.Run(NewGameService(zap.NewNop(), store.NewGameStore()), config)
+			require.Empty(t, report.Failures)
+			require.Equal(t, 50, report.NbFinished)
+			require.Len(t, report.Seats, tc.nbPlayer)
+		})
+	}
+}
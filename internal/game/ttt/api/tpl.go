@@ -3,6 +3,8 @@ package api
 import (
 	"embed"
 	"html/template"
+
+	"github.com/gre-ory/games-go/internal/game/ttt/model"
 )
 
 var (
@@ -12,6 +14,12 @@ var (
 
 var (
 	tpl = template.Must(
-		template.ParseFS(tplFS, "tpl/*.tpl"),
+		template.
+			New("").
+			Funcs(template.FuncMap{
+				"boardSizes": model.BoardSizes,
+				"nbInARows":  model.NbInARows,
+			}).
+			ParseFS(tplFS, "tpl/*.tpl"),
 	)
 )
@@ -0,0 +1,3 @@
+{{- define "countdown" }}
+<div id="countdown" class="countdown center" hx-swap-oob="outerHTML">{{ .Remaining }}s</div>
+{{- end }}
\ No newline at end of file
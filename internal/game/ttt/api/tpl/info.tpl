@@ -2,7 +2,7 @@
 <div id="notifications" hx-swap-oob="innerHTML">
     <div class="info">
         <div class="icon-info"></div>
-        <div class="message">{{ .info }}</div>
+        <div class="message">{{ with .InfoLocKey }}{{ $.Lang.Loc . }}{{ else }}{{ .Info }}{{ end }}</div>
     </div>
 </div>
 {{- end }}
\ No newline at end of file
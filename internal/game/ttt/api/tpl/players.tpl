@@ -3,9 +3,9 @@
 <div id="players" hx-swap-oob="outerHTML">
     <div class="players cols-2">
     {{- $game := .Game }}
-    {{- $current_player := .player }}
+    {{- $current_player := .Player }}
     {{- range $index, $player := .Game.Players }}
-        {{- if eq $player.Id $current_player.Id }}
+        {{- if and $current_player (eq $player.Id $current_player.Id) }}
             <div class="{{ $game.PlayerLabels $player.Id }} current col-1 item">
         {{- else }}
             <div class="{{ $game.PlayerLabels $player.Id }} col-1 item">
@@ -13,16 +13,38 @@
                 <div class="title center">
                     {{ $player.User.Avatar.XS }}
                     <div class="name truncate">{{ $player.User.Name }}</div>
+                    {{- if $game.IsHost $player.Id }}
+                    <div class="host">{{ $lang.Loc "Host" }}</div>
+                    {{- end }}
                 </div>
                 <div class="content center">
-                    {{- if eq $player.Id $current_player.Id }}
+                    {{- if and $current_player (eq $player.Id $current_player.Id) }}
                         {{ $game.YourPlayerMessage $lang $player.Id }}
                     {{- else }}
                         {{ $game.PlayerMessage $lang $player.Id }}
                     {{- end }}
+                    {{- if and $current_player (not $game.WasStarted) ($game.IsHost $current_player.Id) (ne $player.Id $current_player.Id) }}
+                    <button ws-send data-action="kick-player" data-player="{{ $player.Id }}">{{ $lang.Loc "KickAction" }}</button>
+                    {{- end }}
+                    {{- if $game.IsRematch }}
+                    <div class="table-wins">{{ $lang.Loc "TableWins" ($game.NbTableWin $player.Id) $game.NbTableGame }}</div>
+                    {{- end }}
                 </div>
             </div>
     {{- end }}
     </div>
+    {{- with .Spectators }}
+    <div class="spectators item">
+        <div class="title center">{{ $lang.Loc "Spectators" }}</div>
+        <div class="content left">
+        {{- range . }}
+            <div class="badge user spectator">
+                {{ .Avatar.XS }}
+                <div class="name truncate">{{ .Name }}</div>
+            </div>
+        {{- end }}
+        </div>
+    </div>
+    {{- end }}
 </div>
 {{- end }}
\ No newline at end of file
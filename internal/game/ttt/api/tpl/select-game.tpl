@@ -46,7 +46,45 @@
                     <button ws-send data-action="create-game">
                         {{ $lang.Loc "CreateAction" }}
                     </button>
+                    <button ws-send data-action="create-game" data-turn-timeout="30">
+                        {{ $lang.Loc "CreateTimedAction" 30 }}
+                    </button>
+                    <button ws-send data-action="create-game" data-private="true">
+                        {{ $lang.Loc "CreatePrivateAction" }}
+                    </button>
+                    <button ws-send data-action="create-game" data-ready-check="true">
+                        {{ $lang.Loc "CreateReadyCheckAction" }}
+                    </button>
                 </div>
+                <form class="content right" ws-send data-action="create-game">
+                    <label>
+                        {{ $lang.Loc "NbRowLabel" }}
+                        <select name="nb-row">
+                        {{- range boardSizes }}
+                            <option value="{{ . }}"{{ if eq . 3 }} selected{{ end }}>{{ . }}</option>
+                        {{- end }}
+                        </select>
+                    </label>
+                    <label>
+                        {{ $lang.Loc "NbColumnLabel" }}
+                        <select name="nb-column">
+                        {{- range boardSizes }}
+                            <option value="{{ . }}"{{ if eq . 3 }} selected{{ end }}>{{ . }}</option>
+                        {{- end }}
+                        </select>
+                    </label>
+                    <label>
+                        {{ $lang.Loc "NbInARowLabel" }}
+                        <select name="nb-in-a-row">
+                        {{- range nbInARows }}
+                            <option value="{{ . }}"{{ if eq . 3 }} selected{{ end }}>{{ . }}</option>
+                        {{- end }}
+                        </select>
+                    </label>
+                    <button type="submit">
+                        {{ $lang.Loc "CreateBoardAction" }}
+                    </button>
+                </form>
             </div>
         </div>
         {{- range .OtherGames }}
@@ -67,5 +105,28 @@
                 </div>
             </div>
         {{- end }}
+        {{- range .RunningGames }}
+        {{- $game := . }}
+            <div class="cols-1">
+                <div class="spectate-game col-1 item {{ .Labels }}">
+                    <div class="title center">{{ $lang.Loc "GameTitle" .Id }}</div>
+                    <div class="content">
+                        <div class="left">
+                        {{- range .Players }}
+                            <div class="badge user {{ $game.PlayerLabels .Id }}">
+                                {{ .User.Avatar.XS }}
+                                <div class="name truncate">{{ .User.Name }}</div>
+                            </div>
+                        {{- end }}
+                        </div>
+                        <div class="right">
+                            <button ws-send data-action="spectate-game" data-game="{{ .Id }}">
+                                {{ $lang.Loc "SpectateAction" }}
+                            </button>
+                        </div>
+                    </div>
+                </div>
+            </div>
+        {{- end }}
     </div>
 {{- end }}
\ No newline at end of file
@@ -6,7 +6,7 @@ import (
 	"github.com/gre-ory/games-go/internal/game/ttt/model"
 )
 
-func (s *gameServer) HandlePlay(player *model.Player, x, y int) error {
+func (s *gameServer) HandlePlay(player *model.Player, version, x, y int) error {
 	s.logger.Info("[ws] play", zap.Int("x", x), zap.Int("y", y))
 
 	if x == 0 {
@@ -16,7 +16,7 @@ func (s *gameServer) HandlePlay(player *model.Player, x, y int) error {
 		return model.ErrMissingPlayY
 	}
 
-	game, err := s.service.PlayPlayerGame(player, x, y)
+	game, err := s.service.PlayPlayerGame(player, version, x, y)
 	if err != nil {
 		return err
 	}
@@ -58,6 +58,20 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 			break
 		}
 
+		//
+		// stop spectating ( if spectating, any other action is rejected )
+		//
+
+		if user.IsSpectating() {
+			switch jsonMessage.Action {
+			case "leave-game":
+				err = s.HandleStopSpectating(user)
+			default:
+				err = share_model.ErrSpectatorAction
+			}
+			break
+		}
+
 		//
 		// create or join game ( if not playing )
 		//
@@ -73,12 +87,14 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 			switch jsonMessage.Action {
 			case "create-game":
 				s.logger.Info(fmt.Sprintf("[DEBUG] create-game <<< user %s / has-game %t / game %s", user.Id(), user.HasGameId(), user.GameId()))
-				err = s.HandleCreateGame(user)
+				err = s.HandleCreateGame(user, jsonMessage.GameOptions(), jsonMessage.GameSettings())
 				s.logger.Info(fmt.Sprintf("[DEBUG] create-game >>> user %s / has-game %t / game %s", user.Id(), user.HasGameId(), user.GameId()))
 			case "join-game":
 				s.logger.Info(fmt.Sprintf("[DEBUG] join-game %s <<< user %s / has-game %t / game %s", jsonMessage.GameId(), user.Id(), user.HasGameId(), user.GameId()))
 				err = s.HandleJoinGame(jsonMessage.GameId(), user)
 				s.logger.Info(fmt.Sprintf("[DEBUG] join-game %s >>> user %s / has-game %t / game %s", jsonMessage.GameId(), user.Id(), user.HasGameId(), user.GameId()))
+			case "spectate-game":
+				err = s.HandleSpectateGame(jsonMessage.GameId(), user)
 			default:
 				err = share_model.ErrInvalidAction
 			}
@@ -102,14 +118,24 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 		}()
 
 		switch jsonMessage.Action {
+		case "update-settings":
+			err = s.HandleUpdateSettings(player, jsonMessage.GameOptions())
+		case "kick-player":
+			err = s.HandleKickPlayer(player, jsonMessage.PlayerId())
+		case "set-ready":
+			err = s.HandleSetReady(player, jsonMessage.Ready())
 		case "start-game":
 			err = s.HandleStartGame(player)
+		case "add-bot":
+			err = s.HandleAddBot(player)
 		case "play":
-			err = s.HandlePlay(player, jsonMessage.PlayX(), jsonMessage.PlayY())
+			err = s.HandlePlay(player, jsonMessage.Version(), jsonMessage.PlayX(), jsonMessage.PlayY())
 		case "leave-game":
 			s.logger.Info(fmt.Sprintf("[DEBUG] leave-game %s <<< player %s <<< user %s / has-game %t / game %s", jsonMessage.GameId(), playerId, user.Id(), user.HasGameId(), user.GameId()))
 			err = s.HandleLeaveGame(player)
 			s.logger.Info(fmt.Sprintf("[DEBUG] leave-game %s >>> player %s >>> user %s / has-game %t / game %s", jsonMessage.GameId(), playerId, user.Id(), user.HasGameId(), user.GameId()))
+		case "rematch":
+			err = s.HandleRematch(player)
 		default:
 			err = share_model.ErrInvalidAction
 		}
@@ -122,17 +148,54 @@ func (s *gameServer) onMessage(userId share_model.UserId, message []byte) {
 
 type JsonMessage struct {
 	// Headers    *JsonHeaders `json:"HEADERS,omitempty"`
-	Action     string `json:"action,omitempty"`
-	PlayerName string `json:"name,omitempty"`
-	GameIdStr  string `json:"game,omitempty"`
-	PlayXStr   string `json:"x,omitempty"`
-	PlayYStr   string `json:"y,omitempty"`
+	Action         string `json:"action,omitempty"`
+	PlayerName     string `json:"name,omitempty"`
+	GameIdStr      string `json:"game,omitempty"`
+	PlayerIdStr    string `json:"player,omitempty"`
+	ReadyStr       string `json:"ready,omitempty"`
+	PlayXStr       string `json:"x,omitempty"`
+	PlayYStr       string `json:"y,omitempty"`
+	VersionStr     string `json:"version,omitempty"`
+	TurnTimeoutStr string `json:"turn-timeout,omitempty"`
+	PrivateStr     string `json:"private,omitempty"`
+	ReadyCheckStr  string `json:"ready-check,omitempty"`
+	NbRowStr       string `json:"nb-row,omitempty"`
+	NbColumnStr    string `json:"nb-column,omitempty"`
+	NbInARowStr    string `json:"nb-in-a-row,omitempty"`
 }
 
 func (j *JsonMessage) GameId() share_model.GameId {
 	return share_model.GameId(j.GameIdStr)
 }
 
+func (j *JsonMessage) PlayerId() share_model.PlayerId {
+	return share_model.PlayerId(j.PlayerIdStr)
+}
+
+func (j *JsonMessage) Ready() bool {
+	return util.ToBool(j.ReadyStr)
+}
+
+func (j *JsonMessage) Version() int {
+	return util.ToInt(j.VersionStr)
+}
+
+func (j *JsonMessage) GameOptions() share_model.GameOptions {
+	return share_model.GameOptions{
+		TurnTimeout: time.Duration(util.ToInt(j.TurnTimeoutStr)) * time.Second,
+		Private:     util.ToBool(j.PrivateStr),
+		ReadyCheck:  util.ToBool(j.ReadyCheckStr),
+	}
+}
+
+func (j *JsonMessage) GameSettings() model.Settings {
+	return model.Settings{
+		NbRow:    util.ToInt(j.NbRowStr),
+		NbColumn: util.ToInt(j.NbColumnStr),
+		NbInARow: util.ToInt(j.NbInARowStr),
+	}
+}
+
 func (j *JsonMessage) PlayX() int {
 	return util.ToInt(j.PlayXStr)
 }
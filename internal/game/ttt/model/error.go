@@ -10,4 +10,6 @@ var (
 	ErrMissingPlayY      = fmt.Errorf("missing play y")
 	ErrInvalidPlayY      = fmt.Errorf("invalid play y")
 	ErrAlreadyPlayOnCell = fmt.Errorf("already played on cell")
+	ErrInvalidBoardSize  = fmt.Errorf("invalid board size")
+	ErrInvalidNbInARow   = fmt.Errorf("invalid number in a row")
 )
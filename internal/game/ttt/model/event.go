@@ -0,0 +1,20 @@
+package model
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// event type
+
+const (
+	EventType_PlayGame share_model.EventType = "play-game"
+)
+
+// //////////////////////////////////////////////////
+// event payloads
+
+type PlayGamePayload struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
@@ -9,23 +9,89 @@ import (
 
 const (
 	NbPlayer = 2
+
+	Board_DefaultSize     = 3
+	Board_MinSize         = 3
+	Board_MaxSize         = 19
+	Board_DefaultNbInARow = 3
+	Board_MinNbInARow     = 3
+	Board_MaxNbInARow     = 6
 )
 
-func NewGame(nbRow, nbColumn int) *Game {
+// Settings are chosen when creating a game ( zero values mean the game defaults ).
+type Settings struct {
+	// NbRow & NbColumn size the board.
+	NbRow    int `json:"nb-row,omitempty"`
+	NbColumn int `json:"nb-column,omitempty"`
+	// NbInARow is the number of aligned symbols needed to win.
+	NbInARow int `json:"nb-in-a-row,omitempty"`
+}
+
+// NewGameFromSetup sizes the board from the game settings, defaulting to a classic 3x3 board with 3 in a row.
+func NewGameFromSetup(setup share_model.GameSetup) (*Game, error) {
+	settings := Settings{}
+	if err := setup.DecodeSettings(&settings); err != nil {
+		return nil, err
+	}
+	nbRow := withDefault(settings.NbRow, Board_DefaultSize)
+	nbColumn := withDefault(settings.NbColumn, Board_DefaultSize)
+	nbInARow := withDefault(settings.NbInARow, Board_DefaultNbInARow)
+	if nbRow < Board_MinSize || nbRow > Board_MaxSize || nbColumn < Board_MinSize || nbColumn > Board_MaxSize {
+		return nil, ErrInvalidBoardSize
+	}
+	if nbInARow < Board_MinNbInARow || nbInARow > Board_MaxNbInARow || nbInARow > max(nbRow, nbColumn) {
+		return nil, ErrInvalidNbInARow
+	}
+	return NewGame(setup, nbRow, nbColumn, nbInARow), nil
+}
+
+func NewGame(setup share_model.GameSetup, nbRow, nbColumn, nbInARow int) *Game {
 	rows := make(map[int]*Row, nbRow)
 	for y := 1; y <= nbRow; y++ {
 		rows[y] = NewRow(nbColumn)
 	}
 	game := &Game{
-		Game: share_model.NewGame[*Player](NbPlayer, NbPlayer),
-		Rows: rows,
+		BaseGame: share_model.NewGame[*Player](setup, NbPlayer, NbPlayer),
+		NbRow:    nbRow,
+		NbColumn: nbColumn,
+		NbInARow: nbInARow,
+		Rows:     rows,
 	}
 	return game
 }
 
+// BoardSizes lists the number of rows or columns offered when creating a game.
+func BoardSizes() []int {
+	return intRange(Board_MinSize, Board_MaxSize)
+}
+
+// NbInARows lists the number of aligned symbols offered when creating a game.
+func NbInARows() []int {
+	return intRange(Board_MinNbInARow, Board_MaxNbInARow)
+}
+
+func intRange(from, to int) []int {
+	values := make([]int, 0, to-from+1)
+	for value := from; value <= to; value++ {
+		values = append(values, value)
+	}
+	return values
+}
+
+func withDefault(value, defaultValue int) int {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
 type Game struct {
-	share_model.Game[*Player]
-	Rows map[int]*Row
+	*share_model.BaseGame[*Player]
+	NbRow    int
+	NbColumn int
+	// NbInARow is the number of aligned symbols needed to win.
+	NbInARow int
+	Rows     map[int]*Row
 }
 
 func (g *Game) Play(player *Player, x, y int) error {
@@ -35,28 +101,47 @@ func (g *Game) Play(player *Player, x, y int) error {
 	return ErrOutOfRowBound
 }
 
+// lineDirections are the steps ( dx, dy ) of a line: horizontal, vertical, diagonal and anti-diagonal.
+var lineDirections = [][2]int{
+	{1, 0},
+	{0, 1},
+	{1, 1},
+	{1, -1},
+}
+
+// HasWinner looks for NbInARow aligned symbols in any direction.
 func (g *Game) HasWinner() (bool, share_model.PlayerId) {
-	for x := 1; x <= 3; x++ {
-		same, symbol := g.HasSameSymbol(g.Rows[1].Cells[x], g.Rows[2].Cells[x], g.Rows[3].Cells[x])
-		if same {
-			return true, g.GetPlayerIdFromRune(symbol)
+	for y := 1; y <= g.NbRow; y++ {
+		for x := 1; x <= g.NbColumn; x++ {
+			for _, direction := range lineDirections {
+				same, symbol := g.HasSameSymbol(g.Line(x, y, direction[0], direction[1], g.NbInARow)...)
+				if same {
+					return true, g.GetPlayerIdFromRune(symbol)
+				}
+			}
 		}
 	}
-	for y := 1; y <= 3; y++ {
-		same, symbol := g.HasSameSymbol(g.Rows[y].Cells[1], g.Rows[y].Cells[2], g.Rows[y].Cells[3])
-		if same {
-			return true, g.GetPlayerIdFromRune(symbol)
+	return false, ""
+}
+
+// Line returns the length cells starting at ( x, y ) by steps of ( dx, dy ), or nil if it leaves the board.
+func (g *Game) Line(x, y, dx, dy, length int) []*Cell {
+	cells := make([]*Cell, 0, length)
+	for i := 0; i < length; i++ {
+		cell := g.Cell(x+i*dx, y+i*dy)
+		if cell == nil {
+			return nil
 		}
+		cells = append(cells, cell)
 	}
-	same, symbol := g.HasSameSymbol(g.Rows[1].Cells[1], g.Rows[2].Cells[2], g.Rows[3].Cells[3])
-	if same {
-		return true, g.GetPlayerIdFromRune(symbol)
-	}
-	same, symbol = g.HasSameSymbol(g.Rows[1].Cells[3], g.Rows[2].Cells[2], g.Rows[3].Cells[1])
-	if same {
-		return true, g.GetPlayerIdFromRune(symbol)
+	return cells
+}
+
+func (g *Game) Cell(x, y int) *Cell {
+	if row, ok := g.Rows[y]; ok {
+		return row.Cells[x]
 	}
-	return false, ""
+	return nil
 }
 
 func (g *Game) GetPlayerIdFromRune(symbol rune) share_model.PlayerId {
@@ -69,6 +154,9 @@ func (g *Game) GetPlayerIdFromRune(symbol rune) share_model.PlayerId {
 }
 
 func (g *Game) HasSameSymbol(cells ...*Cell) (bool, rune) {
+	if len(cells) == 0 {
+		return false, NO_SYMBOL
+	}
 	symbol := NO_SYMBOL
 	for index, cell := range cells {
 		if cell.IsEmpty() {
@@ -0,0 +1,212 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// newTestGame builds a board from one string per row, "X" and "O" being played by G1-U1 and G1-U2.
+func newTestGame(t *testing.T, nbInARow int, rows ...string) *Game {
+	game := NewGame(share_model.GameSetup{Id: "G1"}, len(rows), len(rows[0]), nbInARow)
+	playerOne := NewPlayerFromUser(game.Id(), share_model.NewUser("U1"))
+	playerOne.SetSymbol(PLAYER_ONE_SYMBOL)
+	playerTwo := NewPlayerFromUser(game.Id(), share_model.NewUser("U2"))
+	playerTwo.SetSymbol(PLAYER_TWO_SYMBOL)
+	game.AttachPlayer(playerOne)
+	game.AttachPlayer(playerTwo)
+	for y, row := range rows {
+		for x, symbol := range row {
+			switch symbol {
+			case PLAYER_ONE_SYMBOL:
+				require.NoError(t, game.Play(playerOne, x+1, y+1))
+			case PLAYER_TWO_SYMBOL:
+				require.NoError(t, game.Play(playerTwo, x+1, y+1))
+			}
+		}
+	}
+	return game
+}
+
+func TestHasWinner(t *testing.T) {
+
+	type TestCase struct {
+		nbInARow   int
+		rows       []string
+		wantWinner share_model.PlayerId
+		wantTie    bool
+	}
+
+	testCases := map[string]TestCase{
+		"empty": {
+			nbInARow: 3,
+			rows:     []string{"...", "...", "..."},
+		},
+		"horizontal": {
+			nbInARow:   3,
+			rows:       []string{"...", "XXX", "OO."},
+			wantWinner: "G1-U1",
+		},
+		"vertical": {
+			nbInARow:   3,
+			rows:       []string{"X.O", "X.O", "..O"},
+			wantWinner: "G1-U2",
+		},
+		"diagonal": {
+			nbInARow:   3,
+			rows:       []string{"X.O", ".XO", "..X"},
+			wantWinner: "G1-U1",
+		},
+		"anti-diagonal": {
+			nbInARow:   3,
+			rows:       []string{"X.O", "XO.", "O.X"},
+			wantWinner: "G1-U2",
+		},
+		"tie": {
+			nbInARow: 3,
+			rows:     []string{"XOX", "XOO", "OXX"},
+			wantTie:  true,
+		},
+		"wide-horizontal-at-edge": {
+			nbInARow:   4,
+			rows:       []string{"......", "..XXXX", "OOO..."},
+			wantWinner: "G1-U1",
+		},
+		"wide-too-short": {
+			nbInARow: 4,
+			rows:     []string{"......", "XXX.XX", "OOO..."},
+		},
+		"tall-vertical-at-edge": {
+			nbInARow:   4,
+			rows:       []string{"...", "..O", "..O", "X.O", "X.O"},
+			wantWinner: "G1-U2",
+		},
+		"tall-broken-vertical": {
+			nbInARow: 4,
+			rows:     []string{"..O", "..O", "..X", "..O", "..O"},
+		},
+		"gomoku-diagonal": {
+			nbInARow: 5,
+			rows: []string{
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"..........X....",
+				"...........X...",
+				"............X..",
+				".............X.",
+				"..............X",
+			},
+			wantWinner: "G1-U1",
+		},
+		"gomoku-anti-diagonal": {
+			nbInARow: 5,
+			rows: []string{
+				"....O..........",
+				"...O...........",
+				"..O............",
+				".O.............",
+				"O..............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+			},
+			wantWinner: "G1-U2",
+		},
+		"gomoku-four-only": {
+			nbInARow: 5,
+			rows: []string{
+				"XXXX...........",
+				"O..............",
+				"O..............",
+				"O..............",
+				"O..............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			game := newTestGame(t, tc.nbInARow, tc.rows...)
+			gotWinner, gotWinnerId := game.HasWinner()
+			require.Equal(t, tc.wantWinner != "", gotWinner)
+			require.Equal(t, tc.wantWinner, gotWinnerId)
+			require.Equal(t, tc.wantTie, game.IsTie())
+		})
+	}
+}
+
+func TestNewGameFromSetup(t *testing.T) {
+
+	type TestCase struct {
+		settings     Settings
+		wantErr      error
+		wantNbRow    int
+		wantNbColumn int
+		wantNbInARow int
+	}
+
+	testCases := map[string]TestCase{
+		"default":           {settings: Settings{}, wantNbRow: 3, wantNbColumn: 3, wantNbInARow: 3},
+		"gomoku":            {settings: Settings{NbRow: 15, NbColumn: 15, NbInARow: 5}, wantNbRow: 15, wantNbColumn: 15, wantNbInARow: 5},
+		"rectangle":         {settings: Settings{NbRow: 3, NbColumn: 7, NbInARow: 4}, wantNbRow: 3, wantNbColumn: 7, wantNbInARow: 4},
+		"too-small":         {settings: Settings{NbRow: 2}, wantErr: ErrInvalidBoardSize},
+		"too-large":         {settings: Settings{NbColumn: 20}, wantErr: ErrInvalidBoardSize},
+		"too-few-in-a-row":  {settings: Settings{NbInARow: 2}, wantErr: ErrInvalidNbInARow},
+		"longer-than-board": {settings: Settings{NbRow: 4, NbColumn: 4, NbInARow: 5}, wantErr: ErrInvalidNbInARow},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			settings, err := share_model.EncodeSettings(tc.settings)
+			require.NoError(t, err)
+			game, err := NewGameFromSetup(share_model.GameSetup{Id: "G1", Settings: settings})
+			require.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tc.wantNbRow, game.NbRow)
+			require.Equal(t, tc.wantNbColumn, game.NbColumn)
+			require.Equal(t, tc.wantNbInARow, game.NbInARow)
+			require.Len(t, game.Rows, tc.wantNbRow)
+			require.Len(t, game.Rows[1].Cells, tc.wantNbColumn)
+
+			snapshot := NewGameSnapshot(game).ToGame()
+			require.Equal(t, tc.wantNbRow, snapshot.NbRow)
+			require.Equal(t, tc.wantNbColumn, snapshot.NbColumn)
+			require.Equal(t, tc.wantNbInARow, snapshot.NbInARow)
+		})
+	}
+}
+
+func TestNewGameFromSetupInvalidSettings(t *testing.T) {
+
+	_, err := NewGameFromSetup(share_model.GameSetup{Id: "G1", Settings: []byte(`{"nb-row":"four"}`)})
+	require.ErrorIs(t, err, share_model.ErrInvalidSettings)
+}
@@ -8,12 +8,12 @@ import (
 
 func NewPlayerFromUser(gameId share_model.GameId, user share_model.User) *Player {
 	return &Player{
-		Player: share_model.NewPlayerFromUser(gameId, user),
+		BasePlayer: share_model.NewPlayerFromUser(gameId, user),
 	}
 }
 
 type Player struct {
-	share_model.Player
+	*share_model.BasePlayer
 	Symbol rune
 }
 
@@ -33,7 +33,7 @@ func (p *Player) IconHtml() template.HTML {
 
 func (p *Player) LabelSlice() []string {
 	labels := make([]string, 0)
-	labels = append(labels, p.Player.LabelSlice()...)
+	labels = append(labels, p.BasePlayer.LabelSlice()...)
 	switch p.Symbol {
 	case PLAYER_ONE_SYMBOL:
 		labels = append(labels, "symbol-1")
@@ -0,0 +1,68 @@
+package model
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+)
+
+// //////////////////////////////////////////////////
+// game snapshot
+
+type GameSnapshot struct {
+	Game     share_model.GameSnapshot `json:"game"`
+	Players  []PlayerSnapshot         `json:"players"`
+	NbInARow int                      `json:"nb-in-a-row,omitempty"`
+	Rows     map[int]*Row             `json:"rows"`
+}
+
+func NewGameSnapshot(game *Game) *GameSnapshot {
+	players := game.Players()
+	snapshot := &GameSnapshot{
+		Game:     game.Snapshot(),
+		Players:  make([]PlayerSnapshot, 0, len(players)),
+		NbInARow: game.NbInARow,
+		Rows:     game.Rows,
+	}
+	for _, player := range players {
+		snapshot.Players = append(snapshot.Players, NewPlayerSnapshot(player))
+	}
+	return snapshot
+}
+
+func (s *GameSnapshot) ToGame() *Game {
+	players := make([]*Player, 0, len(s.Players))
+	for _, player := range s.Players {
+		players = append(players, player.ToPlayer())
+	}
+	game := &Game{
+		BaseGame: share_model.NewGameFromSnapshot(s.Game, players),
+		NbRow:    len(s.Rows),
+		NbInARow: withDefault(s.NbInARow, Board_DefaultNbInARow),
+		Rows:     s.Rows,
+	}
+	if row, ok := s.Rows[1]; ok {
+		game.NbColumn = len(row.Cells)
+	}
+	return game
+}
+
+// //////////////////////////////////////////////////
+// player snapshot
+
+type PlayerSnapshot struct {
+	Player share_model.PlayerSnapshot `json:"player"`
+	Symbol rune                       `json:"symbol"`
+}
+
+func NewPlayerSnapshot(player *Player) PlayerSnapshot {
+	return PlayerSnapshot{
+		Player: player.Snapshot(),
+		Symbol: player.Symbol,
+	}
+}
+
+func (s PlayerSnapshot) ToPlayer() *Player {
+	return &Player{
+		BasePlayer: share_model.NewPlayerFromSnapshot(s.Player),
+		Symbol:     s.Symbol,
+	}
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+	share_service "github.com/gre-ory/games-go/internal/game/share/service"
+
+	"github.com/gre-ory/games-go/internal/game/ttt/model"
+)
+
+// //////////////////////////////////////////////
+// bot
+
+const (
+	// exhaustiveNbEmpty is the number of empty cells below which the bot searches every move until the end of the game.
+	exhaustiveNbEmpty = 10
+	// smallBoardNbCell is the number of cells up to which every empty cell is a candidate move.
+	smallBoardNbCell = 16
+
+	winScore      = 1_000_000_000
+	infiniteScore = 2 * winScore
+)
+
+// lineDirections are the steps ( dx, dy ) of a line: horizontal, vertical, diagonal and anti-diagonal.
+var lineDirections = [][2]int{{1, 0}, {0, 1}, {1, 1}, {1, -1}}
+
+// NewBot returns a minimax bot: it plays perfectly on a classic board and looks a few moves ahead on larger ones.
+func NewBot() share_service.Bot[*model.Player, *model.Game] {
+	return &bot{}
+}
+
+type bot struct{}
+
+func (b *bot) NextAction(game *model.Game, player *model.Player) (share_service.BotAction[*model.Player, *model.Game], error) {
+	board := newBoard(game)
+	index, found := board.bestMove(player.Symbol, otherSymbol(player.Symbol))
+	if !found {
+		return share_service.BotAction[*model.Player, *model.Game]{}, share_model.ErrNoDefaultAction
+	}
+	x, y := board.position(index)
+	return share_service.BotAction[*model.Player, *model.Game]{
+		EventType: model.EventType_PlayGame,
+		Payload:   model.PlayGamePayload{X: x, Y: y},
+		PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+			return playGame(game, player, x, y)
+		},
+	}, nil
+}
+
+func otherSymbol(symbol rune) rune {
+	if symbol == model.PLAYER_ONE_SYMBOL {
+		return model.PLAYER_TWO_SYMBOL
+	}
+	return model.PLAYER_ONE_SYMBOL
+}
+
+// //////////////////////////////////////////////
+// board
+
+// board is a flat copy of the game cells the search plays and undoes moves on.
+type board struct {
+	nbRow    int
+	nbColumn int
+	nbInARow int
+	cells    []rune
+}
+
+func newBoard(game *model.Game) *board {
+	b := &board{
+		nbRow:    game.NbRow,
+		nbColumn: game.NbColumn,
+		nbInARow: game.NbInARow,
+		cells:    make([]rune, 0, game.NbRow*game.NbColumn),
+	}
+	for y := 1; y <= game.NbRow; y++ {
+		for x := 1; x <= game.NbColumn; x++ {
+			b.cells = append(b.cells, game.Cell(x, y).Symbol)
+		}
+	}
+	return b
+}
+
+func (b *board) position(index int) (int, int) {
+	return index%b.nbColumn + 1, index/b.nbColumn + 1
+}
+
+func (b *board) nbEmpty() int {
+	count := 0
+	for _, symbol := range b.cells {
+		if symbol == model.NO_SYMBOL {
+			count++
+		}
+	}
+	return count
+}
+
+func (b *board) symbol(x, y int) (rune, bool) {
+	if x < 1 || x > b.nbColumn || y < 1 || y > b.nbRow {
+		return model.NO_SYMBOL, false
+	}
+	return b.cells[(y-1)*b.nbColumn+(x-1)], true
+}
+
+// isWinningMove tells whether the symbol played at index completes a line.
+func (b *board) isWinningMove(index int, symbol rune) bool {
+	x, y := b.position(index)
+	for _, direction := range lineDirections {
+		count := 1
+		for _, sign := range []int{1, -1} {
+			for step := 1; ; step++ {
+				other, ok := b.symbol(x+sign*step*direction[0], y+sign*step*direction[1])
+				if !ok || other != symbol {
+					break
+				}
+				count++
+			}
+		}
+		if count >= b.nbInARow {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates are the empty cells, restricted on large boards to the neighbours of played cells.
+func (b *board) candidates() []int {
+	restricted := len(b.cells) > smallBoardNbCell
+	if restricted && b.nbEmpty() == len(b.cells) {
+		// empty board: play in the middle
+		return []int{(b.nbRow/2)*b.nbColumn + b.nbColumn/2}
+	}
+	moves := make([]int, 0, len(b.cells))
+	for index, symbol := range b.cells {
+		if symbol != model.NO_SYMBOL {
+			continue
+		}
+		if restricted && !b.hasNeighbour(index) {
+			continue
+		}
+		moves = append(moves, index)
+	}
+	return moves
+}
+
+func (b *board) hasNeighbour(index int) bool {
+	x, y := b.position(index)
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			if symbol, ok := b.symbol(x+dx, y+dy); ok && symbol != model.NO_SYMBOL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *board) searchDepth(nbEmpty int) int {
+	switch {
+	case nbEmpty <= exhaustiveNbEmpty:
+		return nbEmpty
+	case len(b.cells) <= smallBoardNbCell:
+		return 4
+	default:
+		return 2
+	}
+}
+
+// bestMove returns the index of the best cell to play, the first one winning ties.
+func (b *board) bestMove(me, other rune) (int, bool) {
+	nbEmpty := b.nbEmpty()
+	depth := b.searchDepth(nbEmpty)
+	best, bestScore := -1, -infiniteScore
+	for _, move := range b.candidates() {
+		score := b.scoreMove(move, depth, bestScore, infiniteScore, me, other, nbEmpty, 1)
+		if best == -1 || score > bestScore {
+			best, bestScore = move, score
+		}
+	}
+	return best, best != -1
+}
+
+// scoreMove plays the move, scores it from the point of view of the player to move, and undoes it.
+func (b *board) scoreMove(move, depth, alpha, beta int, toMove, other rune, nbEmpty, ply int) int {
+	b.cells[move] = toMove
+	defer func() {
+		b.cells[move] = model.NO_SYMBOL
+	}()
+	switch {
+	case b.isWinningMove(move, toMove):
+		// the sooner the better
+		return winScore - ply
+	case nbEmpty == 1:
+		// tie
+		return 0
+	case depth == 1:
+		return b.evaluate(toMove, other)
+	}
+	return -b.negamax(depth-1, -beta, -alpha, other, toMove, nbEmpty-1, ply+1)
+}
+
+// negamax scores the board from the point of view of the player to move, pruning with alpha-beta.
+func (b *board) negamax(depth, alpha, beta int, toMove, other rune, nbEmpty, ply int) int {
+	for _, move := range b.candidates() {
+		score := b.scoreMove(move, depth, alpha, beta, toMove, other, nbEmpty, ply)
+		if score > alpha {
+			alpha = score
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	return alpha
+}
+
+// evaluate scores every line of NbInARow cells still open to a single player, from the point of view of me.
+func (b *board) evaluate(me, other rune) int {
+	score := 0
+	for y := 1; y <= b.nbRow; y++ {
+		for x := 1; x <= b.nbColumn; x++ {
+			for _, direction := range lineDirections {
+				nbMine, nbOther, ok := b.countLine(x, y, direction[0], direction[1], me, other)
+				switch {
+				case !ok:
+				case nbOther == 0 && nbMine > 0:
+					score += 1 << (2 * nbMine)
+				case nbMine == 0 && nbOther > 0:
+					score -= 1 << (2 * nbOther)
+				}
+			}
+		}
+	}
+	return score
+}
+
+func (b *board) countLine(x, y, dx, dy int, me, other rune) (int, int, bool) {
+	nbMine, nbOther := 0, 0
+	for step := 0; step < b.nbInARow; step++ {
+		symbol, ok := b.symbol(x+step*dx, y+step*dy)
+		if !ok {
+			return 0, 0, false
+		}
+		switch symbol {
+		case me:
+			nbMine++
+		case other:
+			nbOther++
+		}
+	}
+	return nbMine, nbOther, true
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+	share_store "github.com/gre-ory/games-go/internal/game/share/store"
+
+	"github.com/gre-ory/games-go/internal/game/ttt/model"
+)
+
+// newTestBoard builds a board from one string per row, "." being an empty cell.
+func newTestBoard(nbInARow int, rows ...string) *board {
+	b := &board{
+		nbRow:    len(rows),
+		nbColumn: len(rows[0]),
+		nbInARow: nbInARow,
+	}
+	for _, row := range rows {
+		b.cells = append(b.cells, []rune(strings.ReplaceAll(row, ".", string(model.NO_SYMBOL)))...)
+	}
+	return b
+}
+
+func TestBotBestMove(t *testing.T) {
+
+	type TestCase struct {
+		nbInARow int
+		rows     []string
+		symbol   rune
+		wantX    int
+		wantY    int
+	}
+
+	testCases := map[string]TestCase{
+		"win": {
+			nbInARow: 3,
+			rows:     []string{"XX.", "OO.", "..."},
+			symbol:   'X',
+			wantX:    3,
+			wantY:    1,
+		},
+		"block": {
+			nbInARow: 3,
+			rows:     []string{"X..", "OO.", "X.."},
+			symbol:   'X',
+			wantX:    3,
+			wantY:    2,
+		},
+		"win-rather-than-block": {
+			nbInARow: 3,
+			rows:     []string{"OO.", "XX.", "..."},
+			symbol:   'X',
+			wantX:    3,
+			wantY:    2,
+		},
+		"gomoku-win": {
+			nbInARow: 5,
+			rows: []string{
+				"...............",
+				"...............",
+				"...............",
+				"....OOOO.......",
+				"...............",
+				"....XXXX.......",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+			},
+			symbol: 'X',
+			wantX:  4,
+			wantY:  6,
+		},
+		"gomoku-block": {
+			nbInARow: 5,
+			rows: []string{
+				"...............",
+				"...............",
+				"...............",
+				"...X...........",
+				"....O..........",
+				".....O.........",
+				"......O....X...",
+				".......O..X....",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+				"...............",
+			},
+			symbol: 'X',
+			wantX:  9,
+			wantY:  9,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := newTestBoard(tc.nbInARow, tc.rows...)
+			index, found := b.bestMove(tc.symbol, otherSymbol(tc.symbol))
+			require.True(t, found)
+			gotX, gotY := b.position(index)
+			require.Equal(t, tc.wantX, gotX)
+			require.Equal(t, tc.wantY, gotY)
+		})
+	}
+}
+
+func TestBotNeverLoses(t *testing.T) {
+	for _, botSymbol := range []rune{model.PLAYER_ONE_SYMBOL, model.PLAYER_TWO_SYMBOL} {
+		b := newTestBoard(3, "...", "...", "...")
+		requireBotNeverLoses(t, b, model.PLAYER_ONE_SYMBOL, botSymbol)
+	}
+}
+
+// requireBotNeverLoses plays every possible move of the opponent against the best move of the bot.
+func requireBotNeverLoses(t *testing.T, b *board, toMove, botSymbol rune) {
+	nbEmpty := b.nbEmpty()
+	if toMove == botSymbol {
+		move, found := b.bestMove(botSymbol, otherSymbol(botSymbol))
+		require.True(t, found)
+		b.cells[move] = botSymbol
+		if !b.isWinningMove(move, botSymbol) && nbEmpty > 1 {
+			requireBotNeverLoses(t, b, otherSymbol(toMove), botSymbol)
+		}
+		b.cells[move] = model.NO_SYMBOL
+		return
+	}
+	for move, symbol := range b.cells {
+		if symbol != model.NO_SYMBOL {
+			continue
+		}
+		b.cells[move] = toMove
+		require.False(t, b.isWinningMove(move, toMove), "bot %c lost: %q", botSymbol, string(b.cells))
+		if nbEmpty > 1 {
+			requireBotNeverLoses(t, b, otherSymbol(toMove), botSymbol)
+		}
+		b.cells[move] = model.NO_SYMBOL
+	}
+}
+
+func TestAddBot(t *testing.T) {
+
+	service := newTestService()
+	service.SetBotDelay(-1)
+	eventStore := share_store.NewEventMemoryStore()
+	service.RegisterOnEvent(func(event share_model.Event) {
+		require.NoError(t, eventStore.Append(event))
+	})
+
+	user := newTestUser(1)
+	game, err := service.CreateGame(user, share_model.GameOptions{})
+	require.NoError(t, err)
+	human := game.MustPlayer(share_model.NewPlayerId(game.Id(), user.Id()))
+
+	game, err = service.AddBot(human)
+	require.NoError(t, err)
+	require.Equal(t, 2, game.NbPlayer())
+	bots := game.FilterPlayers(func(player *model.Player) bool { return player.IsBot() })
+	require.Len(t, bots, 1)
+	_, err = service.AddBot(bots[0])
+	require.ErrorIs(t, err, share_model.ErrWrongPlayer)
+	_, err = service.AddBot(human)
+	require.ErrorIs(t, err, share_model.ErrGameNotJoinable)
+
+	// bots only play through PlayBot
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+	for !game.IsStopped() {
+		if human.IsPlaying() {
+			x, y := firstEmptyCell(game)
+			_, err = service.PlayPlayerGame(human, game.Version(), x, y)
+		} else {
+			_, err = service.PlayBot(game.Id())
+		}
+		require.NoError(t, err)
+	}
+	require.False(t, human.Result().IsWin())
+	_, err = service.PlayBot(game.Id())
+	require.ErrorIs(t, err, share_model.ErrNoPlayingBot)
+
+	events, err := eventStore.List(game.Id())
+	require.NoError(t, err)
+	replayed, err := service.ReplayGame(events)
+	require.NoError(t, err)
+	require.Equal(t, game.Rows, replayed.Rows)
+	require.True(t, replayed.MustPlayer(bots[0].Id()).IsBot())
+	require.Equal(t, bots[0].Result(), replayed.MustPlayer(bots[0].Id()).Result())
+}
+
+func TestAddBotHost(t *testing.T) {
+
+	service := newTestService()
+	service.SetBotDelay(-1)
+	host := newTestUser(1)
+	game, err := service.CreateGame(host, share_model.GameOptions{})
+	require.NoError(t, err)
+	game, err = service.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+	guest := game.MustPlayer(share_model.NewPlayerId(game.Id(), newTestUser(2).Id()))
+
+	// only the host fills the table with bots
+	_, err = service.AddBot(guest)
+	require.ErrorIs(t, err, share_model.ErrNotHost)
+	game, err = service.LeavePlayerGame(guest)
+	require.NoError(t, err)
+	require.Equal(t, 1, game.NbPlayer())
+	game, err = service.AddBot(game.MustPlayer(share_model.NewPlayerId(game.Id(), host.Id())))
+	require.NoError(t, err)
+	require.Equal(t, 2, game.NbPlayer())
+}
+
+func TestBotLobby(t *testing.T) {
+
+	service := newTestService()
+	user := newTestUser(1)
+	game, err := service.CreateGame(user, share_model.GameOptions{})
+	require.NoError(t, err)
+	human := game.MustPlayer(share_model.NewPlayerId(game.Id(), user.Id()))
+	_, err = service.AddBot(human)
+	require.NoError(t, err)
+
+	// a lobby left to bots is deleted
+	_, err = service.LeavePlayerGame(human)
+	require.NoError(t, err)
+	_, err = service.GetGame(game.Id())
+	require.ErrorIs(t, err, share_model.ErrGameNotFound)
+}
+
+func TestBotDelay(t *testing.T) {
+
+	service := newTestService()
+	service.SetBotDelay(10 * time.Millisecond)
+	user := newTestUser(1)
+	humanId := share_model.PlayerId("")
+	// tells whether it is up to the test to go on, read while the game is locked
+	updates := make(chan bool, 16)
+	service.RegisterOnGame(func(game *model.Game) {
+		updates <- game.IsStopped() || game.IsPlayingPlayer(humanId)
+	})
+
+	game, err := service.CreateGameWithSettings(user, share_model.GameOptions{}, model.Settings{NbRow: 4, NbColumn: 4})
+	require.NoError(t, err)
+	gameId := game.Id()
+	humanId = share_model.NewPlayerId(gameId, user.Id())
+	human := game.MustPlayer(humanId)
+	_, err = service.AddBot(human)
+	require.NoError(t, err)
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+
+	for {
+		select {
+		case ready := <-updates:
+			if !ready {
+				continue
+			}
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "bot did not play")
+		}
+		game, err = service.GetGame(gameId)
+		require.NoError(t, err)
+		if game.IsStopped() {
+			break
+		}
+		x, y := firstEmptyCell(game)
+		_, err = service.PlayPlayerGame(human, game.Version(), x, y)
+		require.NoError(t, err)
+	}
+	require.False(t, human.Result().IsWin())
+}
+
+func firstEmptyCell(game *model.Game) (int, int) {
+	for y := 1; y <= game.NbRow; y++ {
+		for x := 1; x <= game.NbColumn; x++ {
+			if game.Cell(x, y).IsEmpty() {
+				return x, y
+			}
+		}
+	}
+	return 0, 0
+}
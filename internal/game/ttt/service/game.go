@@ -13,15 +13,18 @@ import (
 
 type GameService interface {
 	share_service.GameService[*model.Player, *model.Game]
-	PlayPlayerGame(player *model.Player, x, y int) (*model.Game, error)
+	PlayPlayerGame(player *model.Player, version, x, y int) (*model.Game, error)
+	ReplayGame(events []share_model.Event) (*model.Game, error)
 }
 
 func NewGameService(logger *zap.Logger, gameStore store.GameStore) GameService {
 	plugin := NewGamePlugin()
-	return &gameService{
+	service := &gameService{
 		GameService: share_service.NewGameService(logger, plugin, gameStore),
 		logger:      logger,
 	}
+	service.SetBot(NewBot())
+	return service
 }
 
 type gameService struct {
@@ -31,39 +34,54 @@ type gameService struct {
 
 var _ share_api.GameService[*model.Player, *model.Game] = &gameService{}
 
-func (s *gameService) PlayPlayerGame(player *model.Player, x, y int) (*model.Game, error) {
-	game, err := s.GetGame(player.GameId())
-	if err != nil {
-		return nil, err
-	}
-	return s.PlayGame(game, player, x, y)
+func (s *gameService) PlayPlayerGame(player *model.Player, version, x, y int) (*model.Game, error) {
+	payload := model.PlayGamePayload{X: x, Y: y}
+	return s.Play(player, version, model.EventType_PlayGame, payload, func(game *model.Game, player *model.Player) (bool, error) {
+		return playGame(game, player, x, y)
+	})
 }
 
-func (s *gameService) PlayGame(game *model.Game, player *model.Player, x, y int) (*model.Game, error) {
-	if err := game.Status().CanPlay(); err != nil {
-		return nil, err
-	}
-	if !player.Status().IsPlaying() {
-		return nil, share_model.ErrWrongPlayer
-	}
-
+func playGame(game *model.Game, player *model.Player, x, y int) (bool, error) {
 	err := game.Play(player, x, y)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
 	if yes, winnerId := game.HasWinner(); yes {
 		game.SetWinners(winnerId)
-		s.StopGame(game)
+		return true, nil
 	} else if game.IsTie() {
 		game.SetTie()
-		s.StopGame(game)
-	} else {
-		game.NextRound()
-		game.SetPlayingRoundPlayer()
+		return true, nil
 	}
 
-	return s.SaveGame(game)
+	game.NextRound()
+	game.SetPlayingRoundPlayer()
+	return false, nil
+}
+
+// //////////////////////////////////////////////
+// replay
+
+// ReplayGame rebuilds a game from its recorded events in a separate in-memory service.
+func (s *gameService) ReplayGame(events []share_model.Event) (*model.Game, error) {
+	return share_service.Replay(s.logger, NewGamePlugin(), events, replayEvent)
+}
+
+// replayEvent turns a recorded event into the action it played.
+func replayEvent(event share_model.Event) (func(game *model.Game, player *model.Player) (bool, error), error) {
+	switch event.Type {
+	case model.EventType_PlayGame:
+		var payload model.PlayGamePayload
+		if err := event.DecodePayload(&payload); err != nil {
+			return nil, err
+		}
+		return func(game *model.Game, player *model.Player) (bool, error) {
+			return playGame(game, player, payload.X, payload.Y)
+		}, nil
+	default:
+		return nil, share_model.ErrUnknownEvent
+	}
 }
 
 // //////////////////////////////////////////////
@@ -79,8 +97,11 @@ func (p *gamePlugin) CanCreateGame(user share_model.User) error {
 	return nil
 }
 
-func (p *gamePlugin) CreateGame(user share_model.User) (*model.Game, *model.Player, error) {
-	game := model.NewGame(3, 3)
+func (p *gamePlugin) CreateGame(user share_model.User, setup share_model.GameSetup) (*model.Game, *model.Player, error) {
+	game, err := model.NewGameFromSetup(setup)
+	if err != nil {
+		return nil, nil, err
+	}
 	player := model.NewPlayerFromUser(game.Id(), user)
 	return game, player, nil
 }
@@ -104,10 +125,12 @@ func (p *gamePlugin) CanStartGame(game *model.Game) error {
 func (p *gamePlugin) StartGame(game *model.Game) (*model.Game, error) {
 
 	//
-	// set random order
+	// set order ( random, or rotated from the previous game of the table )
 	//
 
-	game.SetRandomOrder()
+	if !game.SetRematchOrder() {
+		game.SetRandomOrder()
+	}
 	game.OrderedPlayer(0).SetSymbol(model.PLAYER_ONE_SYMBOL)
 	game.OrderedPlayer(1).SetSymbol(model.PLAYER_TWO_SYMBOL)
 
@@ -154,3 +177,15 @@ func (p *gamePlugin) LeaveGame(game *model.Game, player *model.Player) (*model.G
 func (p *gamePlugin) CanDeleteGame(game *model.Game, playerId share_model.PlayerId) error {
 	return nil
 }
+
+func (p *gamePlugin) PlayDefault(game *model.Game, player *model.Player) (bool, error) {
+	// play the first empty cell
+	for y := 1; y <= len(game.Rows); y++ {
+		for x := 1; x <= len(game.Rows[y].Cells); x++ {
+			if game.Rows[y].Cells[x].IsEmpty() {
+				return playGame(game, player, x, y)
+			}
+		}
+	}
+	return false, share_model.ErrNoDefaultAction
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+	share_store "github.com/gre-ory/games-go/internal/game/share/store"
+
+	"github.com/gre-ory/games-go/internal/game/ttt/model"
+	"github.com/gre-ory/games-go/internal/game/ttt/store"
+)
+
+func newTestService() GameService {
+	return NewGameService(zap.NewNop(), store.NewGameStore())
+}
+
+func newTestUser(index int) share_model.User {
+	return share_model.NewUser(share_model.UserId(fmt.Sprintf("U%d", index)))
+}
+
+func newTestPlayer(gameId share_model.GameId, user share_model.User) *model.Player {
+	return model.NewPlayerFromUser(gameId, user)
+}
+
+func TestReplayGame(t *testing.T) {
+
+	service := newTestService()
+	eventStore := share_store.NewEventMemoryStore()
+	service.RegisterOnEvent(func(event share_model.Event) {
+		require.NoError(t, eventStore.Append(event))
+	})
+
+	game, err := service.CreateGame(newTestUser(1), share_model.GameOptions{})
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+	for cell := 0; !game.IsStopped(); cell++ {
+		_, err = service.PlayPlayerGame(game.RoundPlayer(), game.Version(), cell%3+1, cell/3+1)
+		require.NoError(t, err)
+	}
+
+	events, err := eventStore.List(game.Id())
+	require.NoError(t, err)
+	replayed, err := service.ReplayGame(events)
+	require.NoError(t, err)
+
+	require.Equal(t, game.Seed(), replayed.Seed())
+	require.Equal(t, game.Order(), replayed.Order())
+	require.Equal(t, game.Rows, replayed.Rows)
+	require.Equal(t, game.Status(), replayed.Status())
+	for _, player := range game.Players() {
+		replayedPlayer, found := replayed.Player(player.Id())
+		require.True(t, found)
+		require.Equal(t, player.Symbol, replayedPlayer.Symbol)
+		require.Equal(t, player.Result(), replayedPlayer.Result())
+	}
+}
+
+func TestPlayDefault(t *testing.T) {
+
+	service := newTestService()
+	game, err := service.CreateGame(newTestUser(1), share_model.GameOptions{})
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+	_, err = service.PlayPlayerGame(game.RoundPlayer(), game.Version(), 1, 1)
+	require.NoError(t, err)
+
+	// the default move plays the first empty cell
+	player := game.RoundPlayer()
+	stop, err := NewGamePlugin().PlayDefault(game, player)
+	require.NoError(t, err)
+	require.False(t, stop)
+	require.Equal(t, player.Symbol, game.Rows[1].Cells[2].Symbol)
+	require.NotEqual(t, player.Id(), game.RoundPlayer().Id())
+}
+
+func TestRematchBoard(t *testing.T) {
+
+	service := newTestService()
+	settings := model.Settings{NbRow: 4, NbColumn: 5, NbInARow: 4}
+	game, err := service.CreateGameWithSettings(newTestUser(1), share_model.GameOptions{}, settings)
+	require.NoError(t, err)
+	_, err = service.JoinGame(game, newTestUser(2))
+	require.NoError(t, err)
+	_, err = service.StartGame(game)
+	require.NoError(t, err)
+	_, err = service.LeavePlayerGame(game.RoundPlayer())
+	require.NoError(t, err)
+
+	// the rematch is played on the same board
+	rematch, err := service.RematchPlayerGame(game.RoundPlayer())
+	require.NoError(t, err)
+	require.Equal(t, 4, rematch.NbRow)
+	require.Equal(t, 5, rematch.NbColumn)
+	require.Equal(t, 4, rematch.NbInARow)
+}
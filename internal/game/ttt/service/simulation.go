@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	"github.com/gre-ory/games-go/internal/game/ttt/model"
+)
+
+// //////////////////////////////////////////////
+// simulation
+
+// NewSimulationConfig simulates games between bots.
+func NewSimulationConfig(nbPlayer int) simulation.Config[*model.Player, *model.Game] {
+	return simulation.Config[*model.Player, *model.Game]{
+		NewPlayers: simulation.NewPlayers(nbPlayer, NewBot),
+		Invariants: SimulationInvariants(),
+	}
+}
+
+// SimulationInvariants are the rules a simulated game must keep after each action.
+func SimulationInvariants() []simulation.Invariant[*model.Player, *model.Game] {
+	return []simulation.Invariant[*model.Player, *model.Game]{
+		{
+			Name: "alternate",
+			CheckFn: func(game *model.Game) error {
+				nbSymbol := make(map[rune]int)
+				for _, row := range game.Rows {
+					for _, cell := range row.Cells {
+						nbSymbol[cell.Symbol]++
+					}
+				}
+				if gap := nbSymbol[model.PLAYER_ONE_SYMBOL] - nbSymbol[model.PLAYER_TWO_SYMBOL]; gap < -1 || gap > 1 {
+					return fmt.Errorf("%d more %c than %c", gap, model.PLAYER_ONE_SYMBOL, model.PLAYER_TWO_SYMBOL)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "winner",
+			CheckFn: func(game *model.Game) error {
+				hasWinner, winnerId := game.HasWinner()
+				if !game.IsStopped() {
+					if hasWinner || game.IsTie() {
+						return fmt.Errorf("game goes on after its end")
+					}
+					return nil
+				}
+				for _, player := range game.Players() {
+					switch {
+					case hasWinner && player.Id() == winnerId && !player.Result().IsWin():
+						return fmt.Errorf("winner %s did not win", player.Id())
+					case hasWinner && player.Id() != winnerId && !player.Result().IsLoose():
+						return fmt.Errorf("player %s did not loose", player.Id())
+					case !hasWinner && !player.Result().IsTie():
+						return fmt.Errorf("player %s did not tie", player.Id())
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	share_service "github.com/gre-ory/games-go/internal/game/share/service"
+	"github.com/gre-ory/games-go/internal/game/share/simulation"
+
+	"github.com/gre-ory/games-go/internal/game/ttt/model"
+)
+
+func TestSimulation(t *testing.T) {
+
+	type TestCase struct {
+		settings model.Settings
+		nbGame   int
+		wantTies bool
+	}
+
+	testCases := map[string]TestCase{
+		"classic": {
+			nbGame:   100,
+			wantTies: true,
+		},
+		"four-by-four": {
+			settings: model.Settings{NbRow: 4, NbColumn: 4},
+			nbGame:   10,
+		},
+		"gomoku": {
+			settings: model.Settings{NbRow: 9, NbColumn: 9, NbInARow: 5},
+			nbGame:   2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := NewSimulationConfig(model.NbPlayer)
+			config.NbGame = tc.nbGame
+			config.Settings = tc.settings
+			report := simulation.Run(newTestService(), config)
+			require.Empty(t, report.Failures)
+			require.Equal(t, tc.nbGame, report.NbFinished)
+			if tc.wantTies {
+				// perfect players always tie
+				require.Equal(t, tc.nbGame, report.Seats[0].NbTie)
+				require.Equal(t, tc.nbGame, report.Seats[1].NbTie)
+			}
+		})
+	}
+}
+
+func TestSimulationScript(t *testing.T) {
+
+	// the first seat fills the first column whoever starts
+	config := simulation.Config[*model.Player, *model.Game]{
+		NbGame: 20,
+		NewPlayers: func() []share_service.Bot[*model.Player, *model.Game] {
+			return []share_service.Bot[*model.Player, *model.Game]{
+				simulation.NewScript(playAction(1, 1), playAction(1, 2), playAction(1, 3)),
+				simulation.NewScript(playAction(2, 1), playAction(2, 2), playAction(3, 3)),
+			}
+		},
+		Invariants: SimulationInvariants(),
+	}
+	report := simulation.Run(newTestService(), config)
+	require.Empty(t, report.Failures)
+	require.Equal(t, 1.0, report.WinRate(0))
+	require.Equal(t, 0.0, report.WinRate(1))
+	require.Greater(t, report.MeanLength(), 4.9)
+	require.Less(t, report.MeanLength(), 6.1)
+}
+
+func TestSimulationFailures(t *testing.T) {
+
+	type TestCase struct {
+		players   func() []share_service.Bot[*model.Player, *model.Game]
+		wantCheck string
+		wantErr   error
+		wantPanic bool
+	}
+
+	testCases := map[string]TestCase{
+		"rejected-action": {
+			players: func() []share_service.Bot[*model.Player, *model.Game] {
+				return []share_service.Bot[*model.Player, *model.Game]{
+					simulation.NewScript(playAction(1, 1), playAction(1, 1)),
+					simulation.NewScript(playAction(1, 1), playAction(2, 2)),
+				}
+			},
+			wantErr: model.ErrAlreadyPlayOnCell,
+		},
+		"end-of-script": {
+			players: func() []share_service.Bot[*model.Player, *model.Game] {
+				return []share_service.Bot[*model.Player, *model.Game]{
+					simulation.NewScript(playAction(1, 1)),
+					simulation.NewScript(playAction(2, 2)),
+				}
+			},
+			wantErr: simulation.ErrEndOfScript,
+		},
+		"panic": {
+			players: func() []share_service.Bot[*model.Player, *model.Game] {
+				panicking := simulation.NewScript(share_service.BotAction[*model.Player, *model.Game]{
+					EventType: model.EventType_PlayGame,
+					PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+						panic("boom")
+					},
+				})
+				return []share_service.Bot[*model.Player, *model.Game]{panicking, panicking}
+			},
+			wantCheck: "panic",
+			wantPanic: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := simulation.Config[*model.Player, *model.Game]{
+				NbGame:     5,
+				NewPlayers: tc.players,
+			}
+			report := simulation.Run(newTestService(), config)
+			require.Equal(t, 0, report.NbFinished)
+			require.Len(t, report.Failures, 5)
+			for _, failure := range report.Failures {
+				if tc.wantCheck != "" {
+					require.Equal(t, tc.wantCheck, failure.Check)
+				}
+				if tc.wantErr != nil {
+					require.ErrorIs(t, failure.Err, tc.wantErr)
+				}
+				require.Equal(t, tc.wantPanic, failure.Panic)
+				require.NotEmpty(t, failure.GameId)
+			}
+			if tc.wantPanic {
+				require.Equal(t, 5, report.NbPanic())
+			}
+		})
+	}
+}
+
+func playAction(x, y int) share_service.BotAction[*model.Player, *model.Game] {
+	return share_service.BotAction[*model.Player, *model.Game]{
+		EventType: model.EventType_PlayGame,
+		Payload:   model.PlayGamePayload{X: x, Y: y},
+		PlayFn: func(game *model.Game, player *model.Player) (bool, error) {
+			return playGame(game, player, x, y)
+		},
+	}
+}
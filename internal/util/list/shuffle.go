@@ -4,6 +4,6 @@ import (
 	"math/rand"
 )
 
-func Shuffle[T any](items []T) {
-	rand.Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
+func Shuffle[T any](random *rand.Rand, items []T) {
+	random.Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
 }
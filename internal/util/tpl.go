@@ -48,3 +48,7 @@ func TplDict(values ...any) (map[string]any, error) {
 	}
 	return dict, nil
 }
+
+func TplInc(value int) int {
+	return value + 1
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"syscall"
@@ -20,19 +21,26 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 	yaml "gopkg.in/yaml.v2"
 
+	"github.com/gre-ory/games-go/internal/util"
 	"github.com/gre-ory/games-go/internal/util/list"
 
 	share_api "github.com/gre-ory/games-go/internal/game/share/api"
+	share_model "github.com/gre-ory/games-go/internal/game/share/model"
+	share_service "github.com/gre-ory/games-go/internal/game/share/service"
+	share_store "github.com/gre-ory/games-go/internal/game/share/store"
 
 	ttt_api "github.com/gre-ory/games-go/internal/game/ttt/api"
+	ttt_model "github.com/gre-ory/games-go/internal/game/ttt/model"
 	ttt_service "github.com/gre-ory/games-go/internal/game/ttt/service"
 	ttt_store "github.com/gre-ory/games-go/internal/game/ttt/store"
 
 	czm_api "github.com/gre-ory/games-go/internal/game/czm/api"
+	czm_model "github.com/gre-ory/games-go/internal/game/czm/model"
 	czm_service "github.com/gre-ory/games-go/internal/game/czm/service"
 	czm_store "github.com/gre-ory/games-go/internal/game/czm/store"
 
 	skj_api "github.com/gre-ory/games-go/internal/game/skj/api"
+	skj_model "github.com/gre-ory/games-go/internal/game/skj/model"
 	skj_service "github.com/gre-ory/games-go/internal/game/skj/service"
 	skj_store "github.com/gre-ory/games-go/internal/game/skj/store"
 )
@@ -75,9 +83,29 @@ func main() {
 	// store
 	//
 
-	ttt_gameStore := ttt_store.NewGameStore()
-	czm_gameStore := czm_store.NewGameStore()
-	skj_gameStore := skj_store.NewGameStore()
+	var ttt_gameStore ttt_store.GameStore
+	var czm_gameStore czm_store.GameStore
+	var skj_gameStore skj_store.GameStore
+	var ttt_eventStore share_store.EventStore
+	var czm_eventStore share_store.EventStore
+	var skj_eventStore share_store.EventStore
+	if config.Store.Dir == "" {
+		logger.Info("no store dir >>> games are kept in memory only")
+		ttt_gameStore = ttt_store.NewGameStore()
+		czm_gameStore = czm_store.NewGameStore()
+		skj_gameStore = skj_store.NewGameStore()
+		ttt_eventStore = share_store.NewEventMemoryStore()
+		czm_eventStore = share_store.NewEventMemoryStore()
+		skj_eventStore = share_store.NewEventMemoryStore()
+	} else {
+		logger.Info(fmt.Sprintf("loading games from %s...", config.Store.Dir))
+		ttt_gameStore = util.Must(ttt_store.NewGameFileStore(logger, config.Store.AppDir(string(ttt_model.App.Id()))))
+		czm_gameStore = util.Must(czm_store.NewGameFileStore(logger, config.Store.AppDir(string(czm_model.App.Id()))))
+		skj_gameStore = util.Must(skj_store.NewGameFileStore(logger, config.Store.AppDir(string(skj_model.App.Id()))))
+		ttt_eventStore = util.Must(share_store.NewEventFileStore(config.Store.EventDir(string(ttt_model.App.Id()))))
+		czm_eventStore = util.Must(share_store.NewEventFileStore(config.Store.EventDir(string(czm_model.App.Id()))))
+		skj_eventStore = util.Must(share_store.NewEventFileStore(config.Store.EventDir(string(skj_model.App.Id()))))
+	}
 
 	//
 	// service
@@ -87,6 +115,10 @@ func main() {
 	czm_service := czm_service.NewGameService(logger, czm_gameStore)
 	skj_service := skj_service.NewGameService(logger, skj_gameStore)
 
+	ttt_service.RegisterOnEvent(recordEvent(logger, ttt_eventStore))
+	czm_service.RegisterOnEvent(recordEvent(logger, czm_eventStore))
+	skj_service.RegisterOnEvent(recordEvent(logger, skj_eventStore))
+
 	//
 	// api
 	//
@@ -96,6 +128,32 @@ func main() {
 	czm_server := czm_api.NewGameServer(logger, cookie_server, czm_service)
 	skj_server := skj_api.NewGameServer(logger, cookie_server, skj_service)
 
+	//
+	// janitor
+	//
+
+	janitorConfig := config.Janitor.ServiceConfig()
+	ttt_service.StartJanitor(ctx, janitorConfig)
+	czm_service.StartJanitor(ctx, janitorConfig)
+	skj_service.StartJanitor(ctx, janitorConfig)
+
+	//
+	// reconnect
+	//
+
+	ttt_service.SetReconnectGrace(config.Reconnect.Grace)
+	czm_service.SetReconnectGrace(config.Reconnect.Grace)
+	skj_service.SetReconnectGrace(config.Reconnect.Grace)
+
+	//
+	// resume
+	//
+
+	// games reloaded from the store are resumed once their service is set up
+	ttt_service.ResumeGames()
+	czm_service.ResumeGames()
+	skj_service.ResumeGames()
+
 	//
 	// router
 	//
@@ -296,6 +354,17 @@ func registerStaticFiles(router *httprouter.Router) {
 // 	}
 // }
 
+// //////////////////////////////////////////////////
+// events
+
+func recordEvent(logger *zap.Logger, eventStore share_store.EventStore) func(event share_model.Event) {
+	return func(event share_model.Event) {
+		if err := eventStore.Append(event); err != nil {
+			logger.Error(fmt.Sprintf("unable to record %s event of game %s", event.Type, event.GameId), zap.Error(err))
+		}
+	}
+}
+
 // //////////////////////////////////////////////////
 // sigterms
 
@@ -313,12 +382,15 @@ func handleSigTerms() {
 // config
 
 type Config struct {
-	Env     string       `yaml:"env"`
-	App     string       `yaml:"app"`
-	Version string       `yaml:"version"`
-	Log     LogConfig    `yaml:"log"`
-	Cookie  CookieConfig `yaml:"cookie"`
-	Server  ServerConfig `yaml:"server"`
+	Env       string          `yaml:"env"`
+	App       string          `yaml:"app"`
+	Version   string          `yaml:"version"`
+	Log       LogConfig       `yaml:"log"`
+	Cookie    CookieConfig    `yaml:"cookie"`
+	Server    ServerConfig    `yaml:"server"`
+	Store     StoreConfig     `yaml:"store"`
+	Janitor   JanitorConfig   `yaml:"janitor"`
+	Reconnect ReconnectConfig `yaml:"reconnect"`
 }
 
 type LogConfig struct {
@@ -338,6 +410,45 @@ type ServerConfig struct {
 	WhiteListOrigins []string `yaml:"white-list-origins"`
 }
 
+type StoreConfig struct {
+	// empty dir means games are kept in memory only
+	Dir string `yaml:"dir"`
+}
+
+func (c StoreConfig) AppDir(appId string) string {
+	return filepath.Join(c.Dir, appId)
+}
+
+func (c StoreConfig) EventDir(appId string) string {
+	return filepath.Join(c.AppDir(appId), "events")
+}
+
+type JanitorConfig struct {
+	Period time.Duration `yaml:"period"`
+	// idle duration after which a game is expired, by game status ( e.g. joinable, started, stopped )
+	TTL map[string]time.Duration `yaml:"ttl"`
+}
+
+func (c JanitorConfig) ServiceConfig() share_service.JanitorConfig {
+	ttls := make(map[share_model.GameStatus]time.Duration, len(c.TTL))
+	for name, ttl := range c.TTL {
+		status, err := share_model.ParseGameStatus(name)
+		if err != nil {
+			panic(fmt.Errorf("invalid janitor ttl status %q: %s", name, err.Error()))
+		}
+		ttls[status] = ttl
+	}
+	return share_service.JanitorConfig{
+		Period: c.Period,
+		TTLs:   ttls,
+	}
+}
+
+type ReconnectConfig struct {
+	// how long a disconnected player keeps their seat ( zero means forever )
+	Grace time.Duration `yaml:"grace"`
+}
+
 func readConfig() *Config {
 
 	path := os.Getenv("CONFIG_FILE")
@@ -364,6 +475,7 @@ func readConfig() *Config {
 
 	// replace env variables
 	config.Log.File = replaceEnvVariables(config.Log.File)
+	config.Store.Dir = replaceEnvVariables(config.Store.Dir)
 
 	return &config
 }
@@ -32,6 +32,20 @@ function attachDataToRequest( event ) {
     }
 }
 
+// the game version is set once on the board and echoed by every action inside it
+
+const versionAttribute = 'data-version'
+
+function attachVersionToRequest( event ) {
+    if ( 'version' in event.detail.parameters ) {
+        return
+    }
+    let versioned = event.detail.elt.closest( `[${versionAttribute}]` )
+    if ( versioned ) {
+        event.detail.parameters['version'] = versioned.getAttribute( versionAttribute )
+    }
+}
+
 // //////////////////////////////////////////////////
 // events
 
@@ -53,6 +67,7 @@ function defaultOnWsError( event ) {
 
 function defaultOnWsConfigSend( event ) {
     attachDataToRequest( event )
+    attachVersionToRequest( event )
 }
 
 function registerDefaultWsHelpers() {